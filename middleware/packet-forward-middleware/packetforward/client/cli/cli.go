@@ -2,13 +2,22 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/version"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+)
+
+const (
+	flagToRecoverableAccount = "to-recoverable-account"
+	flagPort                 = "port"
 )
 
 // GetQueryCmd returns the query commands for packetforward
@@ -22,6 +31,14 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetCmdParams(),
+		GetCmdPauseState(),
+		GetCmdInFlightPacketsBySender(),
+		GetCmdInFlightPacketsByRefundChannel(),
+		GetCmdIntermediateAccount(),
+		GetCmdRoutes(),
+		GetCmdRoute(),
+		GetCmdReceipt(),
+		GetCmdReceiptsBySender(),
 	)
 
 	return queryCmd
@@ -55,7 +72,338 @@ func GetCmdParams() *cobra.Command {
 	return cmd
 }
 
+// GetCmdPauseState returns the command handler for querying which forwards are halted.
+func GetCmdPauseState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pause-state",
+		Short:   "Query which packetforward forwards are currently halted",
+		Long:    "Query which packetforward forwards are currently halted, globally or for specific channels or denoms",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query packetforward pause-state", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PauseState(cmd.Context(), &types.QueryPauseStateRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res.PauseState)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdInFlightPacketsBySender returns the command handler for querying the in-flight packets of an original sender.
+func GetCmdInFlightPacketsBySender() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "in-flight-packets-by-sender [original-sender]",
+		Short:   "Query the in-flight packets of an original sender",
+		Long:    "Query the packets forwarded on behalf of a sender on the source chain that have not been acknowledged yet",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query packetforward in-flight-packets-by-sender cosmos1...", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.InFlightPacketsBySender(cmd.Context(), &types.QueryInFlightPacketsBySenderRequest{
+				OriginalSender: args[0],
+				Pagination:     pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "in-flight packets")
+
+	return cmd
+}
+
+// GetCmdInFlightPacketsByRefundChannel returns the command handler for querying the in-flight packets
+// that are refunded over a channel.
+func GetCmdInFlightPacketsByRefundChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "in-flight-packets-by-refund-channel [port-id] [channel-id]",
+		Short:   "Query the in-flight packets that are refunded over a channel",
+		Long:    "Query the in-flight packets whose original packet was received on the given port and channel",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query packetforward in-flight-packets-by-refund-channel transfer channel-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.InFlightPacketsByRefundChannel(cmd.Context(), &types.QueryInFlightPacketsByRefundChannelRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "in-flight packets")
+
+	return cmd
+}
+
+// GetCmdIntermediateAccount returns the command handler for querying the intermediate account of an original sender.
+func GetCmdIntermediateAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "intermediate-account [channel-id] [original-sender]",
+		Short:   "Query the intermediate account of an original sender and its balances",
+		Long:    "Query the account that receives the funds forwarded on behalf of an original sender over a channel, and the funds it holds",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query packetforward intermediate-account channel-0 cosmos1...", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IntermediateAccount(cmd.Context(), &types.QueryIntermediateAccountRequest{
+				ChannelId:      args[0],
+				OriginalSender: args[1],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRoutes returns the command handler for querying the registered routes.
+func GetCmdRoutes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "routes",
+		Short:   "Query the registered routes",
+		Long:    "Query the registered routes that forward memos can refer to by name",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query packetforward routes", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Routes(cmd.Context(), &types.QueryRoutesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "routes")
+
+	return cmd
+}
+
+// GetCmdRoute returns the command handler for querying a registered route by name.
+func GetCmdRoute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "route [name]",
+		Short:   "Query a registered route",
+		Long:    "Query the hops of a registered route",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query packetforward route osmosis-via-hub", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Route(cmd.Context(), &types.QueryRouteRequest{
+				Name: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(&res.Route)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdReceipt returns the command handler for querying the forward receipt of an original packet.
+func GetCmdReceipt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "receipt [port-id] [channel-id] [sequence]",
+		Short:   "Query the forward receipt of an original packet",
+		Long:    "Query how the forward of a packet received on a port and channel of this chain settled",
+		Args:    cobra.ExactArgs(3),
+		Example: fmt.Sprintf("%s query packetforward receipt transfer channel-0 1", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid sequence %s: %w", args[2], err)
+			}
+
+			res, err := queryClient.Receipt(cmd.Context(), &types.QueryReceiptRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Sequence:  sequence,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(&res.Receipt)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdReceiptsBySender returns the command handler for querying the forward receipts of an original sender.
+func GetCmdReceiptsBySender() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "receipts-by-sender [original-sender]",
+		Short:   "Query the forward receipts of an original sender",
+		Long:    "Query how the retained forwards on behalf of a sender on the source chain settled",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query packetforward receipts-by-sender cosmos1...", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ReceiptsBySender(cmd.Context(), &types.QueryReceiptsBySenderRequest{
+				OriginalSender: args[0],
+				Pagination:     pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "forward receipts")
+
+	return cmd
+}
+
 // NewTxCmd returns the transaction commands for packetforward
 func NewTxCmd() *cobra.Command {
-	return nil
+	txCmd := &cobra.Command{
+		Use:                        "packetforward",
+		Short:                      "Transaction commands for the packetforward module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewSweepIntermediateAccountCmd(),
+	)
+
+	return txCmd
+}
+
+// NewSweepIntermediateAccountCmd returns the command to sweep the funds left on the intermediate account of an
+// original sender.
+func NewSweepIntermediateAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sweep-intermediate-account [channel-id] [original-sender]",
+		Short: "Return the funds left on the intermediate account of an original sender",
+		Long: "Return the funds left on the intermediate account of an original sender by transferring them back over the channel, " +
+			"or by moving them to the original sender's account on this chain",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s tx packetforward sweep-intermediate-account channel-0 cosmos1...", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toRecoverableAccount, err := cmd.Flags().GetBool(flagToRecoverableAccount)
+			if err != nil {
+				return err
+			}
+
+			portID, err := cmd.Flags().GetString(flagPort)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSweepIntermediateAccount{
+				Signer:               clientCtx.GetFromAddress().String(),
+				PortId:               portID,
+				ChannelId:            args[0],
+				OriginalSender:       args[1],
+				ToRecoverableAccount: toRecoverableAccount,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(flagToRecoverableAccount, false, "Move the funds to the original sender's account on this chain instead of transferring them back")
+	cmd.Flags().String(flagPort, transfertypes.PortID, "The port the funds were received on")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
 }
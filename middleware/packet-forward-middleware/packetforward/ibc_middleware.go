@@ -3,6 +3,7 @@ package packetforward
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,7 +14,6 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
@@ -24,20 +24,23 @@ import (
 	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
 
-var _ porttypes.Middleware = &IBCMiddleware{}
+var (
+	_ porttypes.Middleware   = &IBCMiddleware{}
+	_ types.ForwardRequester = &IBCMiddleware{}
+)
 
 // IBCMiddleware implements the ICS26 callbacks for the forward middleware given the
 // forward keeper and the underlying application.
 type IBCMiddleware struct {
 	app    porttypes.IBCModule
 	keeper *keeper.Keeper
-
-	retriesOnTimeout uint8
-	forwardTimeout   time.Duration
-	refundTimeout    time.Duration
 }
 
-// NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying application.
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying application. The retries and
+// timeouts of forwards are module parameters. The given retries and timeouts are only set in the module
+// parameters by the migration to consensus version 4.
+//
+// Deprecated: use NewIBCMiddlewareWithParams, and keeper.SetLegacyForwardLimits until the chain has migrated.
 func NewIBCMiddleware(
 	app porttypes.IBCModule,
 	k *keeper.Keeper,
@@ -45,12 +48,16 @@ func NewIBCMiddleware(
 	forwardTimeout time.Duration,
 	refundTimeout time.Duration,
 ) IBCMiddleware {
+	k.SetLegacyForwardLimits(retriesOnTimeout, forwardTimeout, refundTimeout)
+	return NewIBCMiddlewareWithParams(app, k)
+}
+
+// NewIBCMiddlewareWithParams creates a new IBCMiddleware given the keeper and underlying application. The retries
+// and timeouts of forwards are module parameters.
+func NewIBCMiddlewareWithParams(app porttypes.IBCModule, k *keeper.Keeper) IBCMiddleware {
 	return IBCMiddleware{
-		app:              app,
-		keeper:           k,
-		retriesOnTimeout: retriesOnTimeout,
-		forwardTimeout:   forwardTimeout,
-		refundTimeout:    refundTimeout,
+		app:    app,
+		keeper: k,
 	}
 }
 
@@ -103,37 +110,29 @@ func (im IBCMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID strin
 
 // OnChanCloseConfirm implements the IBCModule interface.
 func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
-	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+	if err := im.app.OnChanCloseConfirm(ctx, portID, channelID); err != nil {
+		return err
+	}
+
+	// forwards over the closed channel are refunded once they are timed out on close, only report them here.
+	im.keeper.EmitInFlightPacketsForClosedChannel(ctx, portID, channelID)
+	return nil
 }
 
 func getDenomForThisChain(port, channel, counterpartyPort, counterpartyChannel, denom string) string {
+	return transfertypes.ParseDenomTrace(getDenomPathForThisChain(port, channel, counterpartyPort, counterpartyChannel, denom)).IBCDenom()
+}
+
+// getDenomPathForThisChain returns the full denom path, e.g. transfer/channel-0/uatom, of the denom of a
+// received packet on this chain.
+func getDenomPathForThisChain(port, channel, counterpartyPort, counterpartyChannel, denom string) string {
 	counterpartyPrefix := transfertypes.GetDenomPrefix(counterpartyPort, counterpartyChannel)
 	if strings.HasPrefix(denom, counterpartyPrefix) {
 		// unwind denom
-		unwoundDenom := denom[len(counterpartyPrefix):]
-		denomTrace := transfertypes.ParseDenomTrace(unwoundDenom)
-		if denomTrace.Path == "" {
-			// denom is now unwound back to native denom
-			return unwoundDenom
-		}
-		// denom is still IBC denom
-		return denomTrace.IBCDenom()
+		return denom[len(counterpartyPrefix):]
 	}
 	// append port and channel from this chain to denom
-	prefixedDenom := transfertypes.GetDenomPrefix(port, channel) + denom
-	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
-}
-
-// getBoolFromAny returns the bool value is any is a valid bool, otherwise false.
-func getBoolFromAny(value any) bool {
-	if value == nil {
-		return false
-	}
-	boolVal, ok := value.(bool)
-	if !ok {
-		return false
-	}
-	return boolVal
+	return transfertypes.GetDenomPrefix(port, channel) + denom
 }
 
 // GetReceiver returns the receiver address for a given channel and original sender.
@@ -141,23 +140,11 @@ func getBoolFromAny(value any) bool {
 // the receiver address is deterministic and can be used to identify the sender on the
 // initial chain.
 func GetReceiver(channel string, originalSender string) (string, error) {
-	senderStr := fmt.Sprintf("%s/%s", channel, originalSender)
-	senderHash32 := address.Hash(types.ModuleName, []byte(senderStr))
-	sender := sdk.AccAddress(senderHash32[:20])
+	sender := types.IntermediateAccount(channel, originalSender)
 	bech32Prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
 	return sdk.Bech32ifyAddressBytes(bech32Prefix, sender)
 }
 
-// newErrorAcknowledgement returns an error that identifies PFM and provides the error.
-// It's okay if these errors are non-deterministic, because they will not be committed to state, only emitted as events.
-func newErrorAcknowledgement(err error) channeltypes.Acknowledgement {
-	return channeltypes.Acknowledgement{
-		Response: &channeltypes.Acknowledgement_Error{
-			Error: fmt.Sprintf("packet-forward-middleware error: %s", err.Error()),
-		},
-	}
-}
-
 // OnRecvPacket checks the memo field on this packet and if the metadata inside's root key indicates this packet
 // should be handled by the swap middleware it attempts to perform a swap. If the swap is successful
 // the underlying application's OnRecvPacket callback is invoked, an ack error is returned otherwise.
@@ -165,6 +152,37 @@ func (im IBCMiddleware) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	// middlewares written against previous versions still set the forward options as context values.
+	goCtx := ctx.Context()
+	opts := types.ForwardOptions{
+		Nonrefundable:           getBoolFromAny(goCtx.Value(types.NonrefundableKey{})),
+		DisableDenomComposition: getBoolFromAny(goCtx.Value(types.DisableDenomCompositionKey{})),
+		Processed:               getBoolFromAny(goCtx.Value(types.ProcessedKey{})),
+	}
+	return im.OnRecvPacketWithOptions(ctx, packet, relayer, opts)
+}
+
+// getBoolFromAny returns the bool value is any is a valid bool, otherwise false.
+func getBoolFromAny(value any) bool {
+	if value == nil {
+		return false
+	}
+	boolVal, ok := value.(bool)
+	if !ok {
+		return false
+	}
+	return boolVal
+}
+
+// OnRecvPacketWithOptions implements the ForwardRequester interface. It handles the packet like OnRecvPacket,
+// with the forward options of a middleware that wraps this middleware. A packet without forward metadata is
+// passed to the underlying application regardless of the options.
+func (im IBCMiddleware) OnRecvPacketWithOptions(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+	opts types.ForwardOptions,
 ) ibcexported.Acknowledgement {
 	logger := im.keeper.Logger(ctx)
 
@@ -179,6 +197,7 @@ func (im IBCMiddleware) OnRecvPacket(
 		"src-channel", packet.SourceChannel, "src-port", packet.SourcePort,
 		"dst-channel", packet.DestinationChannel, "dst-port", packet.DestinationPort,
 		"amount", data.Amount, "denom", data.Denom, "memo", data.Memo,
+		"forward-options", opts.String(),
 	)
 
 	d := make(map[string]interface{})
@@ -188,80 +207,176 @@ func (im IBCMiddleware) OnRecvPacket(
 		logger.Debug("packetForwardMiddleware OnRecvPacket forward metadata does not exist")
 		return im.app.OnRecvPacket(ctx, packet, relayer)
 	}
+
+	if err := opts.Validate(); err != nil {
+		logger.Error("packetForwardMiddleware OnRecvPacket forward options are invalid", "error", err)
+		return keeper.NewErrorAcknowledgement(err)
+	}
+
+	return im.forwardWithGasLimit(ctx, packet, data, relayer, opts)
+}
+
+// forwardWithGasLimit charges the gas for the memo of the forwarded packet and handles the forward within the
+// gas limit of a single forward. A forward that runs out of gas is refunded with an error acknowledgement instead
+// of running the relayer's transaction out of gas.
+func (im IBCMiddleware) forwardWithGasLimit(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	relayer sdk.AccAddress,
+	opts types.ForwardOptions,
+) (ack ibcexported.Acknowledgement) {
+	params := im.keeper.GetParams(ctx)
+
+	gasMeter := ctx.GasMeter()
+	if params.MaxForwardGas > 0 {
+		gasMeter = sdk.NewGasMeter(params.MaxForwardGas)
+
+		defer func() {
+			r := recover()
+
+			// the gas used by the forward is charged to the relayer's transaction.
+			ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "packet forward")
+
+			if r == nil {
+				return
+			}
+			outOfGas, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			im.keeper.Logger(ctx).Error("packetForwardMiddleware OnRecvPacket forward ran out of gas",
+				"limit", params.MaxForwardGas, "descriptor", outOfGas.Descriptor,
+			)
+			// state changes of the forward are discarded by core IBC for error acknowledgements.
+			ack = keeper.NewErrorAcknowledgement(
+				errorsmod.Wrapf(types.ErrForwardOutOfGas, "limit %d", params.MaxForwardGas),
+			)
+		}()
+	}
+
+	gasMeter.ConsumeGas(sdk.Gas(len(data.Memo))*params.MemoByteGas, "packet forward memo")
+
+	return im.handleForward(ctx.WithGasMeter(gasMeter), packet, data, relayer, opts)
+}
+
+// handleForward receives the funds of a packet with forward metadata and forwards them to the next chain.
+func (im IBCMiddleware) handleForward(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	relayer sdk.AccAddress,
+	opts types.ForwardOptions,
+) ibcexported.Acknowledgement {
+	logger := im.keeper.Logger(ctx)
+
 	m := &types.PacketMetadata{}
-	err = json.Unmarshal([]byte(data.Memo), m)
+	err := json.Unmarshal([]byte(data.Memo), m)
 	if err != nil {
 		logger.Error("packetForwardMiddleware OnRecvPacket error parsing forward metadata", "error", err)
-		return newErrorAcknowledgement(fmt.Errorf("error parsing forward metadata: %w", err))
+		return keeper.NewErrorAcknowledgement(fmt.Errorf("error parsing forward metadata: %w", err))
 	}
 
 	metadata := m.Forward
 
-	goCtx := ctx.Context()
-	processed := getBoolFromAny(goCtx.Value(types.ProcessedKey{}))
-	nonrefundable := getBoolFromAny(goCtx.Value(types.NonrefundableKey{}))
-	disableDenomComposition := getBoolFromAny(goCtx.Value(types.DisableDenomCompositionKey{}))
+	// if this packet's token denom is already the base denom for some native token on this chain,
+	// we do not need to do any further composition of the denom before forwarding the packet
+	denomOnThisChain := data.Denom
+	denomPathOnThisChain := data.Denom
+	if !opts.DisableDenomComposition {
+		denomPathOnThisChain = getDenomPathForThisChain(
+			packet.DestinationPort, packet.DestinationChannel,
+			packet.SourcePort, packet.SourceChannel,
+			data.Denom,
+		)
+		denomOnThisChain = transfertypes.ParseDenomTrace(denomPathOnThisChain).IBCDenom()
+	}
+
+	if err := im.keeper.ExpandForwardRoute(ctx, metadata); err != nil {
+		logger.Error("packetForwardMiddleware OnRecvPacket failed to expand route", "error", err)
+		return keeper.NewErrorAcknowledgement(err)
+	}
+
+	if err := im.keeper.ExpandForwardUnwind(ctx, metadata, denomPathOnThisChain); err != nil {
+		logger.Error("packetForwardMiddleware OnRecvPacket failed to expand unwind", "error", err)
+		return keeper.NewErrorAcknowledgement(err)
+	}
 
 	if err := metadata.Validate(); err != nil {
-		logger.Error("packetForwardMiddleware OnRecvPacket forward metadata is invalid", "error", err)
-		return newErrorAcknowledgement(err)
+		logger.Error("packetForwardMiddleware OnRecvPacket forward metadata is invalid",
+			"forward-options", opts.String(), "error", err,
+		)
+		return keeper.NewErrorAcknowledgement(fmt.Errorf("%w (forward options: %s)", err, opts))
 	}
 
 	// override the receiver so that senders cannot move funds through arbitrary addresses.
 	overrideReceiver, err := GetReceiver(packet.DestinationChannel, data.Sender)
 	if err != nil {
 		logger.Error("packetForwardMiddleware OnRecvPacket failed to construct override receiver", "error", err)
-		return newErrorAcknowledgement(fmt.Errorf("failed to construct override receiver: %w", err))
+		return keeper.NewErrorAcknowledgement(fmt.Errorf("failed to construct override receiver: %w", err))
 	}
 
-	// if this packet has been handled by another middleware in the stack there may be no need to call into the
-	// underlying app, otherwise the transfer module's OnRecvPacket callback could be invoked more than once
-	// which would mint/burn vouchers more than once
-	if !processed {
-		if err := im.receiveFunds(ctx, packet, data, overrideReceiver, relayer); err != nil {
-			logger.Error("packetForwardMiddleware OnRecvPacket error receiving packet", "error", err)
-			return newErrorAcknowledgement(fmt.Errorf("error receiving packet: %w", err))
-		}
-	}
-
-	// if this packet's token denom is already the base denom for some native token on this chain,
-	// we do not need to do any further composition of the denom before forwarding the packet
-	denomOnThisChain := data.Denom
-	if !disableDenomComposition {
-		denomOnThisChain = getDenomForThisChain(
-			packet.DestinationPort, packet.DestinationChannel,
-			packet.SourcePort, packet.SourceChannel,
-			data.Denom,
-		)
+	// halted forwards are rejected before any funds are received so that they are refunded on the source chain.
+	if err := im.keeper.CheckForwardingPaused(ctx, packet.DestinationChannel, metadata.Channel, denomOnThisChain); err != nil {
+		logger.Error("packetForwardMiddleware OnRecvPacket forwarding is paused", "error", err)
+		return keeper.NewErrorAcknowledgement(err)
 	}
 
 	amountInt, ok := sdk.NewIntFromString(data.Amount)
-	if !ok {
+	if !ok || !amountInt.IsPositive() {
 		logger.Error("packetForwardMiddleware OnRecvPacket error parsing amount for forward", "amount", data.Amount)
-		return newErrorAcknowledgement(fmt.Errorf("error parsing amount for forward: %s", data.Amount))
+		return keeper.NewErrorAcknowledgement(fmt.Errorf("error parsing amount for forward: %s", data.Amount))
 	}
 
-	token := sdk.NewCoin(denomOnThisChain, amountInt)
-
-	timeout := time.Duration(metadata.Timeout)
+	// the token is checked before the funds are received, so it must not panic on an invalid denom.
+	token := sdk.Coin{Denom: denomOnThisChain, Amount: amountInt}
+	if err := token.Validate(); err != nil {
+		logger.Error("packetForwardMiddleware OnRecvPacket invalid token for forward", "error", err)
+		return keeper.NewErrorAcknowledgement(fmt.Errorf("invalid token for forward: %w", err))
+	}
 
-	if timeout.Nanoseconds() <= 0 {
-		timeout = im.forwardTimeout
+	// blocked denoms and dust are rejected before any funds are received as well.
+	if err := im.keeper.CheckForwardToken(ctx, token); err != nil {
+		logger.Error("packetForwardMiddleware OnRecvPacket token cannot be forwarded", "error", err)
+		return keeper.NewErrorAcknowledgement(err)
 	}
 
-	var retries uint8
-	if metadata.Retries != nil {
-		retries = *metadata.Retries
-	} else {
-		retries = im.retriesOnTimeout
+	// if this packet has been handled by another middleware in the stack there may be no need to call into the
+	// underlying app, otherwise the transfer module's OnRecvPacket callback could be invoked more than once
+	// which would mint/burn vouchers more than once
+	if !opts.Processed {
+		if err := im.receiveFunds(ctx, packet, data, overrideReceiver, relayer); err != nil {
+			logger.Error("packetForwardMiddleware OnRecvPacket error receiving packet", "error", err)
+			return keeper.NewErrorAcknowledgement(fmt.Errorf("error receiving packet: %w", err))
+		}
 	}
 
-	err = im.keeper.ForwardTransferPacket(ctx, nil, packet, data.Sender, overrideReceiver, metadata, token, retries, timeout, []metrics.Label{}, nonrefundable)
+	// the retries and timeout of the memo are clamped to the bounds set by governance.
+	params := im.keeper.GetParams(ctx)
+	timeout := params.ForwardTimeout(time.Duration(metadata.Timeout))
+	retries := params.ForwardRetries(metadata.Retries)
+
+	err = im.keeper.ForwardTransferPacket(ctx, nil, packet, data.Sender, overrideReceiver, metadata, token, retries, timeout, []metrics.Label{}, opts.Nonrefundable)
 	if err != nil {
 		logger.Error("packetForwardMiddleware OnRecvPacket error forwarding packet", "error", err)
-		return newErrorAcknowledgement(err)
+		return keeper.NewErrorAcknowledgement(err)
 	}
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForward,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyOriginalSender, data.Sender),
+			sdk.NewAttribute(types.AttributeKeyRefundPortID, packet.DestinationPort),
+			sdk.NewAttribute(types.AttributeKeyRefundChannelID, packet.DestinationChannel),
+			sdk.NewAttribute(types.AttributeKeyRefundSequence, strconv.FormatUint(packet.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyForwardPortID, metadata.Port),
+			sdk.NewAttribute(types.AttributeKeyForwardChannelID, metadata.Channel),
+			sdk.NewAttribute(types.AttributeKeyForwardOptions, opts.String()),
+		),
+	)
+
 	// returning nil ack will prevent WriteAcknowledgement from occurring for forwarded packet.
 	// This is intentional so that the acknowledgement will be written later based on the ack/timeout of the forwarded packet.
 	return nil
@@ -340,15 +455,63 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 
 	inFlightPacket := im.keeper.GetAndClearInFlightPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence)
 	if inFlightPacket != nil {
+		if inFlightPacket.Resolved {
+			// the original packet was already acknowledged when the forward channel was closed.
+			return nil
+		}
+		if im.keeper.ErrorAckShouldRetry(inFlightPacket, ack) {
+			// the error acknowledgement should be retried. In order to do that, we need to handle it to refund on
+			// this chain first. If the retry cannot be sent, the forward is refunded instead.
+			cacheCtx, writeCache := ctx.CacheContext()
+			err := im.app.OnAcknowledgementPacket(cacheCtx, packet, acknowledgement, relayer)
+			if err == nil {
+				err = im.keeper.RetryErrorAck(cacheCtx, packet.SourceChannel, packet.SourcePort, data, *inFlightPacket)
+			}
+			if err == nil {
+				writeCache()
+				return nil
+			}
+			im.keeper.Logger(ctx).Error("packetForwardMiddleware error retrying error acknowledgement",
+				"sequence", packet.Sequence,
+				"src-channel", packet.SourceChannel, "src-port", packet.SourcePort,
+				"error", err,
+			)
+		}
+		if err := im.keeper.RefundUnusedRelayerFee(ctx, data, inFlightPacket, false, !ack.Success()); err != nil {
+			return err
+		}
 		// this is a forwarded packet, so override handling to avoid refund from being processed.
 		return im.keeper.WriteAcknowledgementForForwardedPacket(ctx, packet, data, inFlightPacket, ack)
 	}
 
+	if refund, found := im.keeper.GetPendingRefund(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence); found {
+		// this is a refund packet. A failed refund is returned to the intermediate account first, so that it can be
+		// sent again.
+		if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+			return err
+		}
+		return im.keeper.OnRefundPacketSettled(ctx, refund, ack.Success())
+	}
+
 	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
 }
 
 // OnTimeoutPacket implements the IBCModule interface.
 func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	// the timeout closes an ordered channel once it is handled, so it is not used to acknowledge or refund forwards.
+	ctx = im.keeper.WithOrderedTimeout(ctx, packet.SourcePort, packet.SourceChannel)
+
+	if err := im.onTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	// the timeout closes an ordered channel once it is handled, refund the later forwards over it on the source chain.
+	im.keeper.ResolveInFlightPacketsForOrderedTimeout(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	return nil
+}
+
+// onTimeoutPacket handles the timeout of a packet, retrying or refunding it if it is a forwarded packet.
+func (im IBCMiddleware) onTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
 	var data transfertypes.FungibleTokenPacketData
 	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
 		im.keeper.Logger(ctx).Error("packetForwardMiddleware error parsing packet data from timeout packet",
@@ -369,19 +532,37 @@ func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Pac
 
 	inFlightPacket, err := im.keeper.TimeoutShouldRetry(ctx, packet)
 	if inFlightPacket != nil {
+		if inFlightPacket.Resolved {
+			// the original packet was already acknowledged and refunded when the forward channel was closed.
+			im.keeper.RemoveInFlightPacket(ctx, packet)
+			return im.keeper.RefundUnusedRelayerFee(ctx, data, inFlightPacket, true, true)
+		}
 		if err != nil {
 			im.keeper.RemoveInFlightPacket(ctx, packet)
+			if err := im.keeper.RefundUnusedRelayerFee(ctx, data, inFlightPacket, true, true); err != nil {
+				return err
+			}
 			// this is a forwarded packet, so override handling to avoid refund from being processed on this chain.
 			// WriteAcknowledgement with proxied ack to return success/fail to previous chain.
-			return im.keeper.WriteAcknowledgementForForwardedPacket(ctx, packet, data, inFlightPacket, newErrorAcknowledgement(err))
+			return im.keeper.WriteAcknowledgementForForwardedPacket(ctx, packet, data, inFlightPacket, keeper.NewErrorAcknowledgement(err))
 		}
 		// timeout should be retried. In order to do that, we need to handle this timeout to refund on this chain first.
 		if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
 			return err
 		}
+		// the retried packet is tracked under its own sequence.
+		im.keeper.RemoveInFlightPacket(ctx, packet)
 		return im.keeper.RetryTimeout(ctx, packet.SourceChannel, packet.SourcePort, data, inFlightPacket)
 	}
 
+	if refund, found := im.keeper.GetPendingRefund(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence); found {
+		// this is a refund packet. It is returned to the intermediate account first, so that it can be sent again.
+		if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+			return err
+		}
+		return im.keeper.OnRefundPacketSettled(ctx, refund, false)
+	}
+
 	return im.app.OnTimeoutPacket(ctx, packet, relayer)
 }
 
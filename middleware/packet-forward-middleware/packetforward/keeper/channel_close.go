@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+)
+
+// EmitInFlightPacketsForClosedChannel emits an event for every unresolved in-flight forward sent over the given
+// channel, which is being closed. It is called from OnChanCloseConfirm, before core IBC sets the channel state.
+// The forwarded packets may have been received before the channel was closed, so their original packets are not
+// refunded here. They are refunded once the forwarded packets are timed out on close, which proves that they were
+// not received.
+func (k *Keeper) EmitInFlightPacketsForClosedChannel(ctx sdk.Context, portID, channelID string) {
+	err := k.walkInFlightPacketsForChannel(ctx, portID, channelID, 0, func(sequence uint64, inFlightPacket types.InFlightPacket) bool {
+		if inFlightPacket.Resolved {
+			return false
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeInFlightPacketChannelClosed,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyForwardPortID, portID),
+				sdk.NewAttribute(types.AttributeKeyForwardChannelID, channelID),
+				sdk.NewAttribute(types.AttributeKeyForwardSequence, strconv.FormatUint(sequence, 10)),
+				sdk.NewAttribute(types.AttributeKeyRefundPortID, inFlightPacket.RefundPortId),
+				sdk.NewAttribute(types.AttributeKeyRefundChannelID, inFlightPacket.RefundChannelId),
+				sdk.NewAttribute(types.AttributeKeyRefundSequence, strconv.FormatUint(inFlightPacket.RefundSequence, 10)),
+				sdk.NewAttribute(types.AttributeKeyOriginalSender, inFlightPacket.OriginalSenderAddress),
+			),
+		)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// ResolveInFlightPacketsForOrderedTimeout writes an error acknowledgement for the original packet of every in-flight
+// forward sent over the given channel after the timed out packet, if that channel is ordered. The timeout closes an
+// ordered channel after it is handled, so these forwarded packets can no longer be received or acknowledged. The
+// funds of each forward are refunded as if the forward had failed. Forwards sent before the timed out packet were
+// received, since the channel is ordered, and are left to be acknowledged.
+//
+// Resolved entries are kept and marked as resolved so that a later timeout of the forwarded packet on close
+// does not refund the funds a second time. The context must be the one
+// returned by WithOrderedTimeout for the channel.
+func (k *Keeper) ResolveInFlightPacketsForOrderedTimeout(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	if !isClosingChannel(ctx, portID, channelID) {
+		return
+	}
+
+	k.resolveInFlightPackets(ctx, portID, channelID, sequence)
+}
+
+// closingChannelKey is the context key of the ordered channel that core IBC closes once the timeout of a packet
+// sent over it is handled.
+type closingChannelKey struct{}
+
+// WithOrderedTimeout returns the context for handling the timeout of a packet sent over the given channel. If the
+// channel is ordered, the timeout closes it once it is handled, so it is no longer treated as open for writing
+// acknowledgements or sending refunds.
+func (k *Keeper) WithOrderedTimeout(ctx sdk.Context, portID, channelID string) sdk.Context {
+	if !k.isOrderedChannel(ctx, portID, channelID) {
+		return ctx
+	}
+	return ctx.WithContext(context.WithValue(ctx.Context(), closingChannelKey{}, channelKey(portID, channelID)))
+}
+
+// isClosingChannel returns true if the given channel is closed once the timeout handled with the context is.
+func isClosingChannel(ctx sdk.Context, portID, channelID string) bool {
+	closing, ok := ctx.Context().Value(closingChannelKey{}).(string)
+	return ok && closing == channelKey(portID, channelID)
+}
+
+func channelKey(portID, channelID string) string {
+	return portID + "/" + channelID
+}
+
+// isChannelOpen returns true if the given channel exists, is open and is not closed by the timeout being handled.
+func (k *Keeper) isChannelOpen(ctx sdk.Context, portID, channelID string) bool {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	return found && channel.State == channeltypes.OPEN && !isClosingChannel(ctx, portID, channelID)
+}
+
+// isOrderedChannel returns true if the given channel exists and is ordered.
+func (k *Keeper) isOrderedChannel(ctx sdk.Context, portID, channelID string) bool {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	return found && channel.Ordering == channeltypes.ORDERED
+}
+
+// resolveInFlightPackets resolves every in-flight forward sent over the given channel from the given sequence on
+// that is not resolved yet.
+func (k *Keeper) resolveInFlightPackets(ctx sdk.Context, portID, channelID string, fromSequence uint64) {
+	type entry struct {
+		sequence       uint64
+		inFlightPacket types.InFlightPacket
+	}
+
+	// collect entries first, the store must not be written to while iterating.
+	var entries []entry
+
+	err := k.walkInFlightPacketsForChannel(ctx, portID, channelID, fromSequence, func(sequence uint64, inFlightPacket types.InFlightPacket) bool {
+		if !inFlightPacket.Resolved {
+			entries = append(entries, entry{sequence: sequence, inFlightPacket: inFlightPacket})
+		}
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, e := range entries {
+		e := e
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.resolveInFlightPacket(cacheCtx, portID, channelID, e.sequence, &e.inFlightPacket); err != nil {
+			k.Logger(ctx).Error("packetForwardMiddleware error resolving in-flight packet for closed channel",
+				"port", portID, "channel", channelID, "sequence", e.sequence,
+				"error", err,
+			)
+			continue
+		}
+		writeCache()
+	}
+}
+
+func (k *Keeper) resolveInFlightPacket(
+	ctx sdk.Context,
+	portID, channelID string,
+	sequence uint64,
+	inFlightPacket *types.InFlightPacket,
+) error {
+	token := inFlightPacket.ForwardToken
+	if token.Denom == "" {
+		return fmt.Errorf("forwarded token was not recorded for in-flight packet")
+	}
+
+	// rebuild the forwarded packet data, which carries the full denom path.
+	fullDenomPath := token.Denom
+	if strings.HasPrefix(token.Denom, "ibc/") {
+		var err error
+		fullDenomPath, err = k.transferKeeper.DenomPathFromHash(ctx, token.Denom)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the forwarded packet was sent from the intermediate account of the original sender to the receiver of the
+	// forward metadata of the original packet.
+	data := transfertypes.FungibleTokenPacketData{
+		Denom:    fullDenomPath,
+		Amount:   token.Amount.String(),
+		Sender:   types.IntermediateAccount(inFlightPacket.RefundChannelId, inFlightPacket.OriginalSenderAddress).String(),
+		Receiver: forwardReceiver(inFlightPacket),
+	}
+	packet := channeltypes.Packet{
+		Sequence:      sequence,
+		SourcePort:    portID,
+		SourceChannel: channelID,
+		Data:          data.GetBytes(),
+	}
+
+	ack := NewErrorAcknowledgement(fmt.Errorf("forward channel %s is closed", channelID))
+	if err := k.WriteAcknowledgementForForwardedPacket(ctx, packet, data, inFlightPacket, ack); err != nil {
+		return err
+	}
+
+	inFlightPacket.Resolved = true
+	if err := k.setInFlightPacket(ctx, channelID, portID, sequence, inFlightPacket); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInFlightPacketResolved,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyForwardPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyForwardChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, strconv.FormatUint(sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyRefundPortID, inFlightPacket.RefundPortId),
+			sdk.NewAttribute(types.AttributeKeyRefundChannelID, inFlightPacket.RefundChannelId),
+			sdk.NewAttribute(types.AttributeKeyRefundSequence, strconv.FormatUint(inFlightPacket.RefundSequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyOriginalSender, inFlightPacket.OriginalSenderAddress),
+			sdk.NewAttribute(types.AttributeKeyForwardToken, token.String()),
+		),
+	)
+
+	return nil
+}
+
+// forwardReceiver returns the receiver of the forward metadata of the original packet of the in-flight packet, or
+// an empty string if the original packet data cannot be parsed.
+func forwardReceiver(inFlightPacket *types.InFlightPacket) string {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(inFlightPacket.PacketData, &data); err != nil {
+		return ""
+	}
+
+	var metadata types.PacketMetadata
+	if err := json.Unmarshal([]byte(data.Memo), &metadata); err != nil || metadata.Forward == nil {
+		return ""
+	}
+	return metadata.Forward.Receiver
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+)
+
+// forwardChannels returns the channels a forward of the token over the channel may be sent over, in the order they
+// are tried. These are the channel and its open equivalent channels that the token may fail over to. Channels the
+// forward was not attempted over yet are tried first, so that a retry fails over to an equivalent channel.
+func (k *Keeper) forwardChannels(ctx sdk.Context, portID, channelID, denom string, attempted []string) []string {
+	channels := []string{channelID}
+
+	equivalents := k.GetParams(ctx).EquivalentChannels(portID, channelID)
+	if len(equivalents) > 0 {
+		fullDenomPath := denom
+		if strings.HasPrefix(denom, transfertypes.DenomPrefix+"/") {
+			path, err := k.transferKeeper.DenomPathFromHash(ctx, denom)
+			if err != nil {
+				k.Logger(ctx).Error("packetForwardMiddleware error resolving denom trace for failover",
+					"denom", denom,
+					"error", err,
+				)
+				return channels
+			}
+			fullDenomPath = path
+		}
+
+		for _, equivalent := range equivalents {
+			if !types.IsDenomTraceCompatible(portID, channelID, equivalent, fullDenomPath) {
+				continue
+			}
+			if !k.isChannelOpen(ctx, portID, equivalent) {
+				continue
+			}
+			channels = append(channels, equivalent)
+		}
+	}
+
+	sort.SliceStable(channels, func(i, j int) bool {
+		return !contains(attempted, channels[i]) && contains(attempted, channels[j])
+	})
+	return channels
+}
+
+// emitForwardFailover emits an event for a forward that was sent over an equivalent channel instead of the
+// requested channel.
+func emitForwardFailover(
+	ctx sdk.Context,
+	inFlightPacket *types.InFlightPacket,
+	portID, requestedChannelID, channelID string,
+	sequence uint64,
+) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForwardFailover,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyRefundPortID, inFlightPacket.RefundPortId),
+			sdk.NewAttribute(types.AttributeKeyRefundChannelID, inFlightPacket.RefundChannelId),
+			sdk.NewAttribute(types.AttributeKeyRefundSequence, strconv.FormatUint(inFlightPacket.RefundSequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyForwardPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyRequestedChannelID, requestedChannelID),
+			sdk.NewAttribute(types.AttributeKeyForwardChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, strconv.FormatUint(sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyAttemptedChannelIDs, strings.Join(inFlightPacket.AttemptedChannelIds, ",")),
+		),
+	)
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -12,27 +12,50 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 		panic(err)
 	}
 
+	if err := k.SetPauseState(ctx, state.PauseState); err != nil {
+		panic(err)
+	}
+
+	for _, route := range state.Routes {
+		if err := k.SetRoute(ctx, route); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, receipt := range state.Receipts {
+		k.setReceipt(ctx, receipt)
+	}
+
+	for _, refund := range state.PendingRefunds {
+		k.setPendingRefund(ctx, refund)
+	}
+
 	// Initialize store refund path for forwarded packets in genesis state that have not yet been acked.
-	store := ctx.KVStore(k.storeKey)
-	for key, value := range state.InFlightPackets {
-		key := key
-		value := value
-		bz := k.cdc.MustMarshal(&value)
-		store.Set([]byte(key), bz)
+	for _, entry := range state.InFlightPackets {
+		entry := entry
+		if err := k.setInFlightPacket(ctx, entry.ChannelId, entry.PortId, entry.Sequence, &entry.InFlightPacket); err != nil {
+			panic(err)
+		}
 	}
 }
 
 // ExportGenesis
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
-	store := ctx.KVStore(k.storeKey)
+	var inFlightPackets []types.InFlightPacketEntry
 
-	inFlightPackets := make(map[string]types.InFlightPacket)
+	k.IterateInFlightPackets(ctx, func(key types.InFlightPacketKey, inFlightPacket types.InFlightPacket) bool {
+		inFlightPackets = append(inFlightPackets, types.NewInFlightPacketEntry(key.K1().K2(), key.K1().K1(), key.K2(), inFlightPacket))
+		return false
+	})
+	// the collection orders the in-flight packets by the channel of their forwarded packet before its port.
+	types.SortInFlightPacketEntries(inFlightPackets)
 
-	itr := store.Iterator(nil, nil)
-	for ; itr.Valid(); itr.Next() {
-		var inFlightPacket types.InFlightPacket
-		k.cdc.MustUnmarshal(itr.Value(), &inFlightPacket)
-		inFlightPackets[string(itr.Key())] = inFlightPacket
+	return &types.GenesisState{
+		Params:          k.GetParams(ctx),
+		InFlightPackets: inFlightPackets,
+		PauseState:      k.GetPauseState(ctx),
+		Routes:          k.GetAllRoutes(ctx),
+		Receipts:        k.GetAllReceipts(ctx),
+		PendingRefunds:  k.GetAllPendingRefunds(ctx),
 	}
-	return &types.GenesisState{Params: k.GetParams(ctx), InFlightPackets: inFlightPackets}
 }
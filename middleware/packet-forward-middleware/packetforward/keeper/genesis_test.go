@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExportGenesis_SortsInFlightPackets(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	pfmKeeper := setup.Keepers.PacketForwardKeeper
+
+	pfmKeeper.InitGenesis(ctx, *types.NewGenesisState(types.DefaultParams(), []types.InFlightPacketEntry{
+		types.NewInFlightPacketEntry("transfer", "channel-1", 10, types.InFlightPacket{RefundChannelId: "channel-0"}),
+		types.NewInFlightPacketEntry("transfer", "channel-1", 9, types.InFlightPacket{RefundChannelId: "channel-0"}),
+		types.NewInFlightPacketEntry("transfer", "channel-0", 1, types.InFlightPacket{RefundChannelId: "channel-2"}),
+	}))
+
+	// the store orders sequence 10 before 9, while the genesis orders sequences numerically.
+	exported := pfmKeeper.ExportGenesis(ctx).InFlightPackets
+	require.Len(t, exported, 3)
+	for i, exp := range []struct {
+		channelID string
+		sequence  uint64
+	}{
+		{"channel-0", 1},
+		{"channel-1", 9},
+		{"channel-1", 10},
+	} {
+		require.Equal(t, "transfer", exported[i].PortId)
+		require.Equal(t, exp.channelID, exported[i].ChannelId)
+		require.Equal(t, exp.sequence, exported[i].Sequence)
+	}
+}
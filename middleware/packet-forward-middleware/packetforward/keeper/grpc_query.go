@@ -4,8 +4,16 @@ import (
 	"context"
 
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -18,3 +26,229 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 		Params: &params,
 	}, nil
 }
+
+func (k Keeper) PauseState(c context.Context, _ *types.QueryPauseStateRequest) (*types.QueryPauseStateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	pauseState := k.GetPauseState(ctx)
+
+	return &types.QueryPauseStateResponse{
+		PauseState: &pauseState,
+	}, nil
+}
+
+func (k Keeper) InFlightPacketsBySender(
+	c context.Context,
+	req *types.QueryInFlightPacketsBySenderRequest,
+) (*types.QueryInFlightPacketsBySenderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.OriginalSender == "" {
+		return nil, status.Error(codes.InvalidArgument, "original sender cannot be empty")
+	}
+
+	senderPrefix, err := indexPrefix(types.InFlightPacketBySenderKeyPrefix, collections.StringKey, req.OriginalSender)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	inFlightPackets, pageRes, err := k.paginateInFlightPackets(ctx, senderPrefix, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryInFlightPacketsBySenderResponse{
+		InFlightPackets: inFlightPackets,
+		Pagination:      pageRes,
+	}, nil
+}
+
+func (k Keeper) InFlightPacketsByRefundChannel(
+	c context.Context,
+	req *types.QueryInFlightPacketsByRefundChannelRequest,
+) (*types.QueryInFlightPacketsByRefundChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	refundChannelPrefix, err := indexPrefix(
+		types.InFlightPacketByRefundChannelKeyPrefix, refundChannelKeyCodec, collections.Join(req.PortId, req.ChannelId),
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	inFlightPackets, pageRes, err := k.paginateInFlightPackets(ctx, refundChannelPrefix, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryInFlightPacketsByRefundChannelResponse{
+		InFlightPackets: inFlightPackets,
+		Pagination:      pageRes,
+	}, nil
+}
+
+// paginateInFlightPackets returns a page of the in-flight packets indexed under the given index prefix, under which
+// the index holds the keys of the indexed in-flight packets.
+func (k Keeper) paginateInFlightPackets(
+	ctx sdk.Context,
+	indexPrefix []byte,
+	pagination *query.PageRequest,
+) ([]types.InFlightPacketEntry, *query.PageResponse, error) {
+	var inFlightPackets []types.InFlightPacketEntry
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+	pageRes, err := query.Paginate(store, pagination, func(key, _ []byte) error {
+		_, inFlightPacketKey, err := types.InFlightPacketKeyCodec.Decode(key)
+		if err != nil {
+			return err
+		}
+
+		entry, err := k.inFlightPacketEntry(ctx, inFlightPacketKey)
+		if err != nil {
+			return err
+		}
+
+		inFlightPackets = append(inFlightPackets, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return inFlightPackets, pageRes, nil
+}
+
+func (k Keeper) IntermediateAccount(
+	c context.Context,
+	req *types.QueryIntermediateAccountRequest,
+) (*types.QueryIntermediateAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.OriginalSender == "" {
+		return nil, status.Error(codes.InvalidArgument, "original sender cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	intermediateAccount := types.IntermediateAccount(req.ChannelId, req.OriginalSender)
+
+	return &types.QueryIntermediateAccountResponse{
+		Address:  intermediateAccount.String(),
+		Balances: k.bankKeeper.GetAllBalances(ctx, intermediateAccount),
+	}, nil
+}
+
+func (k Keeper) Routes(c context.Context, req *types.QueryRoutesRequest) (*types.QueryRoutesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RouteKeyPrefix)
+
+	var routes []types.Route
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var route types.Route
+		if err := k.cdc.Unmarshal(value, &route); err != nil {
+			return err
+		}
+
+		routes = append(routes, route)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRoutesResponse{
+		Routes:     routes,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) Route(c context.Context, req *types.QueryRouteRequest) (*types.QueryRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "route name cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	route, found := k.GetRoute(ctx, req.Name)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "route %s not found", req.Name)
+	}
+
+	return &types.QueryRouteResponse{
+		Route: route,
+	}, nil
+}
+
+func (k Keeper) Receipt(c context.Context, req *types.QueryReceiptRequest) (*types.QueryReceiptResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	receipt, found := k.GetReceipt(ctx, req.PortId, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "forward receipt of packet %s/%s/%d not found", req.PortId, req.ChannelId, req.Sequence)
+	}
+
+	return &types.QueryReceiptResponse{
+		Receipt: receipt,
+	}, nil
+}
+
+func (k Keeper) ReceiptsBySender(
+	c context.Context,
+	req *types.QueryReceiptsBySenderRequest,
+) (*types.QueryReceiptsBySenderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.OriginalSender == "" {
+		return nil, status.Error(codes.InvalidArgument, "original sender cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReceiptBySenderPrefix(req.OriginalSender))
+
+	var receipts []types.ForwardReceipt
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		receipt, err := k.receiptEntry(ctx, key)
+		if err != nil {
+			return err
+		}
+
+		receipts = append(receipts, receipt)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryReceiptsBySenderResponse{
+		Receipts:   receipts,
+		Pagination: pageRes,
+	}, nil
+}
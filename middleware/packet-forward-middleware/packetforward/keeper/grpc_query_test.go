@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func TestQueryInFlightPackets(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	goCtx := sdk.WrapSDKContext(ctx)
+	pfmKeeper := setup.Keepers.PacketForwardKeeper
+
+	const (
+		sender      = "cosmos1wnlew8ss0sqclfalvj6jkcyvnwq79fd74qxxue"
+		otherSender = "osmo1wnlew8ss0sqclfalvj6jkcyvnwq79fd7mz3qxc"
+	)
+
+	pfmKeeper.InitGenesis(ctx, *types.NewGenesisState(types.DefaultParams(), []types.InFlightPacketEntry{
+		types.NewInFlightPacketEntry("transfer", "channel-0", 1, types.InFlightPacket{
+			OriginalSenderAddress: sender, RefundPortId: "transfer", RefundChannelId: "channel-1",
+		}),
+		types.NewInFlightPacketEntry("transfer", "channel-0", 2, types.InFlightPacket{
+			OriginalSenderAddress: sender, RefundPortId: "transfer", RefundChannelId: "channel-2",
+		}),
+		types.NewInFlightPacketEntry("transfer", "channel-3", 1, types.InFlightPacket{
+			OriginalSenderAddress: otherSender, RefundPortId: "transfer", RefundChannelId: "channel-1",
+		}),
+	}))
+
+	bySender, err := pfmKeeper.InFlightPacketsBySender(goCtx, &types.QueryInFlightPacketsBySenderRequest{
+		OriginalSender: sender,
+	})
+	require.NoError(t, err)
+	require.Len(t, bySender.InFlightPackets, 2)
+	for i, entry := range bySender.InFlightPackets {
+		require.Equal(t, "channel-0", entry.ChannelId)
+		require.Equal(t, "transfer", entry.PortId)
+		require.Equal(t, uint64(i+1), entry.Sequence)
+		require.Equal(t, sender, entry.InFlightPacket.OriginalSenderAddress)
+	}
+
+	byRefundChannel, err := pfmKeeper.InFlightPacketsByRefundChannel(goCtx, &types.QueryInFlightPacketsByRefundChannelRequest{
+		PortId:     "transfer",
+		ChannelId:  "channel-1",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, byRefundChannel.InFlightPackets, 1)
+	require.Equal(t, uint64(2), byRefundChannel.Pagination.Total)
+	require.Equal(t, "channel-0", byRefundChannel.InFlightPackets[0].ChannelId)
+
+	// the index entries are removed together with the in-flight packet.
+	require.NotNil(t, pfmKeeper.GetAndClearInFlightPacket(ctx, "channel-0", "transfer", 1))
+
+	bySender, err = pfmKeeper.InFlightPacketsBySender(goCtx, &types.QueryInFlightPacketsBySenderRequest{
+		OriginalSender: sender,
+	})
+	require.NoError(t, err)
+	require.Len(t, bySender.InFlightPackets, 1)
+	require.Equal(t, uint64(2), bySender.InFlightPackets[0].Sequence)
+
+	byRefundChannel, err = pfmKeeper.InFlightPacketsByRefundChannel(goCtx, &types.QueryInFlightPacketsByRefundChannelRequest{
+		PortId:    "transfer",
+		ChannelId: "channel-1",
+	})
+	require.NoError(t, err)
+	require.Len(t, byRefundChannel.InFlightPackets, 1)
+	require.Equal(t, "channel-3", byRefundChannel.InFlightPackets[0].ChannelId)
+
+	_, err = pfmKeeper.InFlightPacketsByRefundChannel(goCtx, &types.QueryInFlightPacketsByRefundChannelRequest{
+		PortId:    "transfer",
+		ChannelId: "",
+	})
+	require.Error(t, err)
+}
+
+func TestQueryRoutes(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	goCtx := sdk.WrapSDKContext(ctx)
+	pfmKeeper := setup.Keepers.PacketForwardKeeper
+
+	hubRoute := types.NewRoute("osmosis-via-hub", types.NewRouteHop("transfer", "channel-0"), types.NewRouteHop("transfer", "channel-141"))
+	junoRoute := types.NewRoute("juno", types.NewRouteHop("transfer", "channel-42"))
+	require.NoError(t, pfmKeeper.SetRoute(ctx, hubRoute))
+	require.NoError(t, pfmKeeper.SetRoute(ctx, junoRoute))
+	require.Error(t, pfmKeeper.SetRoute(ctx, types.NewRoute("empty")))
+
+	routes, err := pfmKeeper.Routes(goCtx, &types.QueryRoutesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.Route{junoRoute, hubRoute}, routes.Routes)
+
+	route, err := pfmKeeper.Route(goCtx, &types.QueryRouteRequest{Name: hubRoute.Name})
+	require.NoError(t, err)
+	require.Equal(t, hubRoute, route.Route)
+
+	require.NoError(t, pfmKeeper.RemoveRoute(ctx, hubRoute.Name))
+	require.ErrorIs(t, pfmKeeper.RemoveRoute(ctx, hubRoute.Name), types.ErrRouteNotFound)
+
+	_, err = pfmKeeper.Route(goCtx, &types.QueryRouteRequest{Name: hubRoute.Name})
+	require.Error(t, err)
+
+	require.Equal(t, []types.Route{junoRoute}, pfmKeeper.ExportGenesis(ctx).Routes)
+}
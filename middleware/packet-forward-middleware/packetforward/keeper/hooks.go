@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+)
+
+// SetHooks sets the hooks that are called as forwards progress. Use types.NewMultiForwardHooks to set multiple
+// hooks. It panics if the hooks were set before.
+func (k *Keeper) SetHooks(hooks types.ForwardHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set forward hooks twice")
+	}
+
+	k.hooks = hooks
+	return k
+}
+
+// callHook calls a hook with a cached context whose writes are only kept if the hook succeeds. The hooks only
+// observe forwards, so a failing hook is logged and does not fail the forward or its acknowledgement.
+func (k *Keeper) callHook(ctx sdk.Context, name string, hook func(ctx sdk.Context, hooks types.ForwardHooks) error) {
+	if k.hooks == nil {
+		return
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := hook(cacheCtx, k.hooks); err != nil {
+		k.Logger(ctx).Error("packetForwardMiddleware hook failed",
+			"hook", name,
+			"error", err,
+		)
+		return
+	}
+	writeCache()
+}
+
+// afterForwardInitiated calls the AfterForwardInitiated hook for the forwarded packet.
+func (k *Keeper) afterForwardInitiated(
+	ctx sdk.Context,
+	portID, channelID string,
+	sequence uint64,
+	inFlightPacket *types.InFlightPacket,
+) {
+	k.callHook(ctx, "AfterForwardInitiated", func(ctx sdk.Context, hooks types.ForwardHooks) error {
+		return hooks.AfterForwardInitiated(ctx, portID, channelID, sequence, *inFlightPacket)
+	})
+}
+
+// afterForwardSettled calls the hook of the outcome of the forwarded packet once the original packet was
+// acknowledged with the acknowledgement of the forwarded packet.
+func (k *Keeper) afterForwardSettled(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	inFlightPacket *types.InFlightPacket,
+	ack channeltypes.Acknowledgement,
+) {
+	portID, channelID, sequence := packet.SourcePort, packet.SourceChannel, packet.Sequence
+
+	switch {
+	case ack.Success():
+		k.callHook(ctx, "AfterForwardAcked", func(ctx sdk.Context, hooks types.ForwardHooks) error {
+			return hooks.AfterForwardAcked(ctx, portID, channelID, sequence, *inFlightPacket)
+		})
+	case inFlightPacket.Nonrefundable:
+		k.callHook(ctx, "AfterForwardRecovered", func(ctx sdk.Context, hooks types.ForwardHooks) error {
+			return hooks.AfterForwardRecovered(ctx, portID, channelID, sequence, *inFlightPacket, ack.GetError())
+		})
+	default:
+		k.callHook(ctx, "AfterForwardRefunded", func(ctx sdk.Context, hooks types.ForwardHooks) error {
+			return hooks.AfterForwardRefunded(ctx, portID, channelID, sequence, *inFlightPacket, ack.GetError())
+		})
+	}
+}
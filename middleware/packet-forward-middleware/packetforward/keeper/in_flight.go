@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// refundChannelKeyCodec is the key codec of the refund port and channel the in-flight packets are indexed by.
+var refundChannelKeyCodec = collections.PairKeyCodec(collections.StringKey, collections.StringKey)
+
+// inFlightPacketIndexes are the indexes of the in-flight packet collection.
+type inFlightPacketIndexes struct {
+	// bySender indexes in-flight packets by original sender.
+	bySender *indexes.Multi[string, types.InFlightPacketKey, types.InFlightPacket]
+	// byRefundChannel indexes in-flight packets by refund port and channel.
+	byRefundChannel *indexes.Multi[collections.Pair[string, string], types.InFlightPacketKey, types.InFlightPacket]
+}
+
+func (i inFlightPacketIndexes) IndexesList() []collections.Index[types.InFlightPacketKey, types.InFlightPacket] {
+	return []collections.Index[types.InFlightPacketKey, types.InFlightPacket]{i.bySender, i.byRefundChannel}
+}
+
+func newInFlightPacketIndexes(sb *collections.SchemaBuilder) inFlightPacketIndexes {
+	return inFlightPacketIndexes{
+		bySender: indexes.NewMulti(
+			sb, collections.NewPrefix(types.InFlightPacketBySenderKeyPrefix), "in_flight_packets_by_sender",
+			collections.StringKey, types.InFlightPacketKeyCodec,
+			func(_ types.InFlightPacketKey, inFlightPacket types.InFlightPacket) (string, error) {
+				return inFlightPacket.OriginalSenderAddress, nil
+			},
+		),
+		byRefundChannel: indexes.NewMulti(
+			sb, collections.NewPrefix(types.InFlightPacketByRefundChannelKeyPrefix), "in_flight_packets_by_refund_channel",
+			refundChannelKeyCodec, types.InFlightPacketKeyCodec,
+			func(_ types.InFlightPacketKey, inFlightPacket types.InFlightPacket) (collections.Pair[string, string], error) {
+				return collections.Join(inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId), nil
+			},
+		),
+	}
+}
+
+// setInFlightPacket stores the in-flight packet of a forwarded packet and indexes it by original sender and
+// by refund channel.
+func (k *Keeper) setInFlightPacket(
+	ctx sdk.Context,
+	channelID, portID string,
+	sequence uint64,
+	inFlightPacket *types.InFlightPacket,
+) error {
+	return k.inFlightPackets.Set(ctx, types.NewInFlightPacketKey(channelID, portID, sequence), *inFlightPacket)
+}
+
+// getInFlightPacket returns the in-flight packet of a forwarded packet, or nil if the packet was not forwarded.
+func (k *Keeper) getInFlightPacket(ctx sdk.Context, channelID, portID string, sequence uint64) *types.InFlightPacket {
+	inFlightPacket, err := k.inFlightPackets.Get(ctx, types.NewInFlightPacketKey(channelID, portID, sequence))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		panic(err)
+	}
+	return &inFlightPacket
+}
+
+// deleteInFlightPacket removes the in-flight packet of a forwarded packet and its index entries.
+func (k *Keeper) deleteInFlightPacket(ctx sdk.Context, channelID, portID string, sequence uint64) {
+	if err := k.inFlightPackets.Remove(ctx, types.NewInFlightPacketKey(channelID, portID, sequence)); err != nil {
+		panic(err)
+	}
+}
+
+// walkInFlightPacketsForChannel calls cb with the sequence and in-flight packet of every packet forwarded over the
+// given channel from the given sequence on, in order of sequence. Iteration stops when cb returns true.
+func (k *Keeper) walkInFlightPacketsForChannel(
+	ctx sdk.Context,
+	portID, channelID string,
+	fromSequence uint64,
+	cb func(sequence uint64, inFlightPacket types.InFlightPacket) (stop bool),
+) error {
+	ranger := collections.NewPrefixedPairRange[collections.Pair[string, string], uint64](collections.Join(channelID, portID)).
+		StartInclusive(fromSequence)
+	return k.walkInFlightPackets(ctx, ranger, func(key types.InFlightPacketKey, inFlightPacket types.InFlightPacket) bool {
+		return cb(key.K2(), inFlightPacket)
+	})
+}
+
+// walkInFlightPackets calls cb with the key and in-flight packet of every in-flight packet in the range.
+// Iteration stops when cb returns true.
+func (k *Keeper) walkInFlightPackets(
+	ctx sdk.Context,
+	ranger collections.Ranger[types.InFlightPacketKey],
+	cb func(key types.InFlightPacketKey, inFlightPacket types.InFlightPacket) (stop bool),
+) error {
+	err := k.inFlightPackets.Walk(ctx, ranger, cb)
+	// the collection reports a range without in-flight packets as an invalid iterator.
+	if errors.Is(err, collections.ErrInvalidIterator) {
+		return nil
+	}
+	return err
+}
+
+// inFlightPacketEntry returns the in-flight packet stored under the given key.
+func (k *Keeper) inFlightPacketEntry(ctx sdk.Context, key types.InFlightPacketKey) (types.InFlightPacketEntry, error) {
+	inFlightPacket, err := k.inFlightPackets.Get(ctx, key)
+	if err != nil {
+		return types.InFlightPacketEntry{}, fmt.Errorf("in-flight packet not found for indexed key %s: %w",
+			types.InFlightPacketKeyCodec.Stringify(key), err)
+	}
+
+	return types.NewInFlightPacketEntry(key.K1().K2(), key.K1().K1(), key.K2(), inFlightPacket), nil
+}
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// RegisterInvariants registers all packetforward invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "in-flight-packet-commitments",
+		InFlightPacketCommitmentsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "in-flight-escrow",
+		InFlightEscrowInvariant(k))
+}
+
+// AllInvariants runs all invariants of the packetforward module.
+func AllInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := InFlightPacketCommitmentsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return InFlightEscrowInvariant(k)(ctx)
+	}
+}
+
+// InFlightPacketCommitmentsInvariant checks that every in-flight packet has a matching
+// packet commitment for the forwarded packet on its forward channel.
+func InFlightPacketCommitmentsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		k.IterateInFlightPackets(ctx, func(key types.InFlightPacketKey, _ types.InFlightPacket) bool {
+			channelID, portID, sequence := key.K1().K1(), key.K1().K2(), key.K2()
+			if len(k.channelKeeper.GetPacketCommitment(ctx, portID, channelID, sequence)) == 0 {
+				msg += fmt.Sprintf("\tno packet commitment for in-flight packet on port %s channel %s sequence %d\n", portID, channelID, sequence)
+				broken = true
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(
+			types.ModuleName,
+			"in-flight packet commitments",
+			fmt.Sprintf("found in-flight packet(s) without a packet commitment:\n%s", msg)), broken
+	}
+}
+
+// InFlightEscrowInvariant checks that the tokens of outstanding forwards that were escrowed
+// on this chain are held by the escrow account of their forward channel, and that the
+// transfer module's total escrow for each denom covers them.
+//
+// Once the counterparty has received a forwarded packet, the vouchers it minted may be sent
+// back and unescrowed before the forward is acknowledged. Forwards over channels whose
+// counterparty is this chain are therefore only counted until their packet is received.
+func InFlightEscrowInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		escrowed := make(map[string]sdk.Coins)
+		var totalEscrowed sdk.Coins
+
+		k.IterateInFlightPackets(ctx, func(key types.InFlightPacketKey, inFlightPacket types.InFlightPacket) bool {
+			// resolved packets were already refunded when their forward channel was closed.
+			if inFlightPacket.Resolved {
+				return false
+			}
+
+			token := inFlightPacket.ForwardToken
+			// in-flight packets stored before the forwarded token was recorded cannot be checked.
+			if token.Denom == "" || !token.IsPositive() {
+				return false
+			}
+
+			channelID, portID, sequence := key.K1().K1(), key.K1().K2(), key.K2()
+			if k.receivedOnLocalhost(ctx, portID, channelID, sequence) {
+				return false
+			}
+
+			fullDenomPath := token.Denom
+			if strings.HasPrefix(token.Denom, "ibc/") {
+				var err error
+				fullDenomPath, err = k.transferKeeper.DenomPathFromHash(ctx, token.Denom)
+				if err != nil {
+					msg += fmt.Sprintf("\tunknown denom %s for in-flight packet %s: %s\n",
+						token.Denom, types.RefundPacketKey(channelID, portID, sequence), err)
+					broken = true
+					return false
+				}
+			}
+
+			// tokens that were not escrowed when forwarded were burned instead.
+			if !transfertypes.SenderChainIsSource(portID, channelID, fullDenomPath) {
+				return false
+			}
+
+			escrowAddress := transfertypes.GetEscrowAddress(portID, channelID).String()
+			escrowed[escrowAddress] = escrowed[escrowAddress].Add(token)
+			totalEscrowed = totalEscrowed.Add(token)
+			return false
+		})
+
+		escrowAddresses := make([]string, 0, len(escrowed))
+		for escrowAddress := range escrowed {
+			escrowAddresses = append(escrowAddresses, escrowAddress)
+		}
+		sort.Strings(escrowAddresses)
+
+		for _, escrowAddress := range escrowAddresses {
+			for _, coin := range escrowed[escrowAddress] {
+				balance := k.bankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(escrowAddress), coin.Denom)
+				if balance.IsLT(coin) {
+					msg += fmt.Sprintf("\tescrow account %s holds %s but outstanding forwards escrowed %s\n", escrowAddress, balance, coin)
+					broken = true
+				}
+			}
+		}
+
+		for _, coin := range totalEscrowed {
+			totalEscrow := k.transferKeeper.GetTotalEscrowForDenom(ctx, coin.Denom)
+			if totalEscrow.IsLT(coin) {
+				msg += fmt.Sprintf("\ttotal escrow is %s but outstanding forwards escrowed %s\n", totalEscrow, coin)
+				broken = true
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName,
+			"in-flight escrow",
+			fmt.Sprintf("found outstanding forwards that are not backed by escrow:\n%s", msg)), broken
+	}
+}
+
+// receivedOnLocalhost returns true if the packet sent on the given channel was received by its
+// counterparty on this chain over the localhost connection.
+func (k *Keeper) receivedOnLocalhost(ctx sdk.Context, portID, channelID string, sequence uint64) bool {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found || len(channel.ConnectionHops) == 0 || channel.ConnectionHops[0] != ibcexported.LocalhostConnectionID {
+		return false
+	}
+
+	_, found = k.channelKeeper.GetPacketReceipt(ctx, channel.Counterparty.PortId, channel.Counterparty.ChannelId, sequence)
+	return found
+}
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/keeper"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+func TestInFlightPacketCommitmentsInvariant(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	pfmKeeper := setup.Keepers.PacketForwardKeeper
+
+	pfmKeeper.InitGenesis(ctx, *types.NewGenesisState(types.DefaultParams(), []types.InFlightPacketEntry{
+		types.NewInFlightPacketEntry("transfer", "channel-0", 1, types.InFlightPacket{RefundChannelId: "channel-1"}),
+	}))
+
+	invariant := keeper.InFlightPacketCommitmentsInvariant(pfmKeeper)
+
+	setup.Mocks.ChannelKeeperMock.EXPECT().GetPacketCommitment(ctx, "transfer", "channel-0", uint64(1)).Return([]byte{0x01})
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	setup.Mocks.ChannelKeeperMock.EXPECT().GetPacketCommitment(ctx, "transfer", "channel-0", uint64(1)).Return(nil)
+	msg, broken := invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "sequence 1")
+}
+
+func TestInFlightEscrowInvariant(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	pfmKeeper := setup.Keepers.PacketForwardKeeper
+
+	escrowAddress := transfertypes.GetEscrowAddress("transfer", "channel-0")
+	ibcDenom := transfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom()
+
+	pfmKeeper.InitGenesis(ctx, *types.NewGenesisState(types.DefaultParams(), []types.InFlightPacketEntry{
+		// native token escrowed on this chain.
+		types.NewInFlightPacketEntry("transfer", "channel-0", 1, types.InFlightPacket{ForwardToken: sdk.NewInt64Coin("uatom", 60)}),
+		types.NewInFlightPacketEntry("transfer", "channel-0", 2, types.InFlightPacket{ForwardToken: sdk.NewInt64Coin("uatom", 40)}),
+		// voucher returning to its source chain is burned rather than escrowed.
+		types.NewInFlightPacketEntry("transfer", "channel-0", 3, types.InFlightPacket{ForwardToken: sdk.NewInt64Coin(ibcDenom, 10)}),
+		// in-flight packets without a recorded forward token are skipped.
+		types.NewInFlightPacketEntry("transfer", "channel-0", 4, types.InFlightPacket{}),
+	}))
+
+	invariant := keeper.InFlightEscrowInvariant(pfmKeeper)
+
+	setup.Mocks.ChannelKeeperMock.EXPECT().GetChannel(ctx, "transfer", "channel-0").
+		Return(channeltypes.Channel{ConnectionHops: []string{"connection-0"}}, true).AnyTimes()
+	setup.Mocks.TransferKeeperMock.EXPECT().DenomPathFromHash(ctx, ibcDenom).Return("transfer/channel-0/uatom", nil)
+	setup.Mocks.BankKeeperMock.EXPECT().GetBalance(ctx, escrowAddress, "uatom").Return(sdk.NewInt64Coin("uatom", 100))
+	setup.Mocks.TransferKeeperMock.EXPECT().GetTotalEscrowForDenom(ctx, "uatom").Return(sdk.NewInt64Coin("uatom", 150))
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	setup.Mocks.TransferKeeperMock.EXPECT().DenomPathFromHash(ctx, ibcDenom).Return("transfer/channel-0/uatom", nil)
+	setup.Mocks.BankKeeperMock.EXPECT().GetBalance(ctx, escrowAddress, "uatom").Return(sdk.NewInt64Coin("uatom", 99))
+	setup.Mocks.TransferKeeperMock.EXPECT().GetTotalEscrowForDenom(ctx, "uatom").Return(sdk.NewInt64Coin("uatom", 90))
+	msg, broken := invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "holds 99uatom but outstanding forwards escrowed 100uatom")
+	require.Contains(t, msg, "total escrow is 90uatom but outstanding forwards escrowed 100uatom")
+}
+
+func TestInFlightEscrowInvariant_ReceivedOverLocalhost(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	pfmKeeper := setup.Keepers.PacketForwardKeeper
+
+	escrowAddress := transfertypes.GetEscrowAddress("transfer", "channel-0")
+
+	pfmKeeper.InitGenesis(ctx, *types.NewGenesisState(types.DefaultParams(), []types.InFlightPacketEntry{
+		types.NewInFlightPacketEntry("transfer", "channel-0", 1, types.InFlightPacket{ForwardToken: sdk.NewInt64Coin("uatom", 60)}),
+		types.NewInFlightPacketEntry("transfer", "channel-0", 2, types.InFlightPacket{ForwardToken: sdk.NewInt64Coin("uatom", 40)}),
+	}))
+
+	invariant := keeper.InFlightEscrowInvariant(pfmKeeper)
+
+	// the vouchers of the received forward may already have been sent back and unescrowed.
+	setup.Mocks.ChannelKeeperMock.EXPECT().GetChannel(ctx, "transfer", "channel-0").Return(channeltypes.Channel{
+		Counterparty:   channeltypes.NewCounterparty("transfer", "channel-1"),
+		ConnectionHops: []string{ibcexported.LocalhostConnectionID},
+	}, true).Times(2)
+	setup.Mocks.ChannelKeeperMock.EXPECT().GetPacketReceipt(ctx, "transfer", "channel-1", uint64(1)).Return("", true)
+	setup.Mocks.ChannelKeeperMock.EXPECT().GetPacketReceipt(ctx, "transfer", "channel-1", uint64(2)).Return("", false)
+	setup.Mocks.BankKeeperMock.EXPECT().GetBalance(ctx, escrowAddress, "uatom").Return(sdk.NewInt64Coin("uatom", 40))
+	setup.Mocks.TransferKeeperMock.EXPECT().GetTotalEscrowForDenom(ctx, "uatom").Return(sdk.NewInt64Coin("uatom", 40))
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/armon/go-metrics"
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -22,6 +23,7 @@ import (
 
 	"github.com/cometbft/cometbft/libs/log"
 
+	feetypes "github.com/cosmos/ibc-go/v7/modules/apps/29-fee/types"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
@@ -38,10 +40,14 @@ var (
 	}
 
 	// DefaultForwardTransferPacketTimeoutTimestamp is the timeout timestamp following IBC defaults
-	DefaultForwardTransferPacketTimeoutTimestamp = time.Duration(transfertypes.DefaultRelativePacketTimeoutTimestamp) * time.Nanosecond
+	//
+	// Deprecated: the timeout of forwards is the default_forward_timeout module parameter.
+	DefaultForwardTransferPacketTimeoutTimestamp = types.DefaultForwardTimeout
 
 	// DefaultRefundTransferPacketTimeoutTimestamp is a 28-day timeout for refund packets since funds are stuck in packetforward module otherwise.
-	DefaultRefundTransferPacketTimeoutTimestamp = 28 * 24 * time.Hour
+	//
+	// Deprecated: the timeout of refund packets is the refund_timeout module parameter.
+	DefaultRefundTransferPacketTimeoutTimestamp = types.DefaultRefundTimeout
 )
 
 // Keeper defines the packet forward middleware keeper
@@ -49,11 +55,23 @@ type Keeper struct {
 	cdc      codec.BinaryCodec
 	storeKey storetypes.StoreKey
 
+	Schema          collections.Schema
+	params          collections.Item[types.Params]
+	inFlightPackets *collections.IndexedMap[types.InFlightPacketKey, types.InFlightPacket, inFlightPacketIndexes]
+
 	transferKeeper types.TransferKeeper
 	channelKeeper  types.ChannelKeeper
 	distrKeeper    types.DistributionKeeper
 	bankKeeper     types.BankKeeper
+	feeKeeper      types.FeeKeeper
 	ics4Wrapper    porttypes.ICS4Wrapper
+	hooks          types.ForwardHooks
+
+	// the retries and timeouts of forwards the middleware was constructed with, which the migration to consensus
+	// version 4 moves into the module parameters.
+	legacyRetriesOnTimeout uint8
+	legacyForwardTimeout   time.Duration
+	legacyRefundTimeout    time.Duration
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -71,7 +89,9 @@ func NewKeeper(
 	ics4Wrapper porttypes.ICS4Wrapper,
 	authority string,
 ) *Keeper {
-	return &Keeper{
+	sb := collections.NewSchemaBuilderFromAccessor(storeAccessor(key))
+
+	k := &Keeper{
 		cdc:            cdc,
 		storeKey:       key,
 		transferKeeper: transferKeeper,
@@ -80,7 +100,26 @@ func NewKeeper(
 		bankKeeper:     bankKeeper,
 		ics4Wrapper:    ics4Wrapper,
 		authority:      authority,
+
+		legacyForwardTimeout: types.DefaultForwardTimeout,
+		legacyRefundTimeout:  types.DefaultRefundTimeout,
+
+		params: collections.NewItem(
+			sb, collections.NewPrefix(types.ParamsKey), "params", newProtoValue[types.Params](cdc),
+		),
+		inFlightPackets: collections.NewIndexedMap(
+			sb, collections.NewPrefix(types.InFlightPacketKeyPrefix), "in_flight_packets",
+			types.InFlightPacketKeyCodec, newProtoValue[types.InFlightPacket](cdc), newInFlightPacketIndexes(sb),
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
 	}
+	k.Schema = schema
+
+	return k
 }
 
 // GetAuthority returns the module's authority.
@@ -93,6 +132,21 @@ func (k *Keeper) SetTransferKeeper(transferKeeper types.TransferKeeper) {
 	k.transferKeeper = transferKeeper
 }
 
+// SetFeeKeeper sets the ICS-29 feeKeeper used to pay relayer fees for forwarded packets.
+// Relayer fees requested in forward metadata are rejected if it is not set.
+func (k *Keeper) SetFeeKeeper(feeKeeper types.FeeKeeper) {
+	k.feeKeeper = feeKeeper
+}
+
+// SetLegacyForwardLimits sets the retries and timeouts of forwards that the middleware was constructed with before
+// they became module parameters. The migration to consensus version 4 sets them in the module parameters, the
+// defaults are used if they are not set.
+func (k *Keeper) SetLegacyForwardLimits(retriesOnTimeout uint8, forwardTimeout, refundTimeout time.Duration) {
+	k.legacyRetriesOnTimeout = retriesOnTimeout
+	k.legacyForwardTimeout = forwardTimeout
+	k.legacyRefundTimeout = refundTimeout
+}
+
 // Logger returns a module-specific logger.
 func (k *Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+ibcexported.ModuleName+"-"+types.ModuleName)
@@ -108,46 +162,59 @@ func (k *Keeper) moveFundsToUserRecoverableAccount(
 	data transfertypes.FungibleTokenPacketData,
 	inFlightPacket *types.InFlightPacket,
 ) error {
+	userAccount, err := userRecoverableAccount(inFlightPacket)
+	if err != nil {
+		return fmt.Errorf("failed to get user recoverable account: %w", err)
+	}
+
+	_, err = k.moveFundsToAccount(ctx, packet, data, userAccount)
+	return err
+}
+
+// moveFundsToAccount will move the funds of the forwarded packet to the account, as the transfer module would refund
+// them to the sender of the packet: the vouchers burned for the packet are minted again, and escrowed tokens are
+// unescrowed. The moved funds are returned.
+func (k *Keeper) moveFundsToAccount(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	account sdk.AccAddress,
+) (sdk.Coin, error) {
 	fullDenomPath := data.Denom
 
 	amount, ok := sdk.NewIntFromString(data.Amount)
 	if !ok {
-		return fmt.Errorf("failed to parse amount from packet data for forward recovery: %s", data.Amount)
+		return sdk.Coin{}, fmt.Errorf("failed to parse amount from packet data for forward recovery: %s", data.Amount)
 	}
 	denomTrace := transfertypes.ParseDenomTrace(fullDenomPath)
 	token := sdk.NewCoin(denomTrace.IBCDenom(), amount)
 
-	userAccount, err := userRecoverableAccount(inFlightPacket)
-	if err != nil {
-		return fmt.Errorf("failed to get user recoverable account: %w", err)
-	}
-
 	if !transfertypes.SenderChainIsSource(packet.SourcePort, packet.SourceChannel, fullDenomPath) {
 		// mint vouchers back to sender
 		if err := k.bankKeeper.MintCoins(
 			ctx, transfertypes.ModuleName, sdk.NewCoins(token),
 		); err != nil {
-			return err
+			return sdk.Coin{}, err
 		}
 
-		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, transfertypes.ModuleName, userAccount, sdk.NewCoins(token)); err != nil {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, transfertypes.ModuleName, account, sdk.NewCoins(token)); err != nil {
 			panic(fmt.Sprintf("unable to send coins from module to account despite previously minting coins to module account: %v", err))
 		}
-		return nil
+		return token, nil
 	}
 
 	escrowAddress := transfertypes.GetEscrowAddress(packet.SourcePort, packet.SourceChannel)
 
 	if err := k.bankKeeper.SendCoins(
-		ctx, escrowAddress, userAccount, sdk.NewCoins(token),
+		ctx, escrowAddress, account, sdk.NewCoins(token),
 	); err != nil {
-		return fmt.Errorf("failed to send coins from escrow account to user recoverable account: %w", err)
+		return sdk.Coin{}, fmt.Errorf("failed to send coins from escrow account to recovery account: %w", err)
 	}
 
 	// update the total escrow amount for the denom.
 	k.unescrowToken(ctx, token)
 
-	return nil
+	return token, nil
 }
 
 // userRecoverableAccount finds an account on this chain that the original sender of the packet can recover funds from.
@@ -172,6 +239,40 @@ func userRecoverableAccount(inFlightPacket *types.InFlightPacket) (sdk.AccAddres
 	return nil, fmt.Errorf("failed to decode bech32 addresses: %w", errors.Join(err, fallbackErr))
 }
 
+// feeSummaryAcknowledgement returns the successful acknowledgement of the forwarded packet with the fees charged
+// for the forward added to the fee summary of its result, so that the source chain can display the fees of every
+// hop. The acknowledgement is returned unchanged if no hop charged fees.
+func feeSummaryAcknowledgement(
+	ack channeltypes.Acknowledgement,
+	inFlightPacket *types.InFlightPacket,
+) (channeltypes.Acknowledgement, error) {
+	hop := types.HopFees{
+		PortID:    inFlightPacket.RefundPortId,
+		ChannelID: inFlightPacket.RefundChannelId,
+		Fees:      inFlightPacket.Fees,
+	}
+	if inFlightPacket.RelayerFee != nil {
+		// the timeout fee is refunded once the forwarded packet is acknowledged.
+		hop.RelayerFee = inFlightPacket.RelayerFee.RecvFee.Add(inFlightPacket.RelayerFee.AckFee...)
+	}
+
+	result, err := types.AddHopFees(ack.GetResult(), hop)
+	if err != nil {
+		return channeltypes.Acknowledgement{}, err
+	}
+	return channeltypes.NewResultAcknowledgement(result), nil
+}
+
+// NewErrorAcknowledgement returns an error that identifies PFM and provides the error.
+// It's okay if these errors are non-deterministic, because they will not be committed to state, only emitted as events.
+func NewErrorAcknowledgement(err error) channeltypes.Acknowledgement {
+	return channeltypes.Acknowledgement{
+		Response: &channeltypes.Acknowledgement_Error{
+			Error: fmt.Sprintf("packet-forward-middleware error: %s", err.Error()),
+		},
+	}
+}
+
 func (k *Keeper) WriteAcknowledgementForForwardedPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -185,6 +286,14 @@ func (k *Keeper) WriteAcknowledgementForForwardedPacket(
 		return errorsmod.Wrap(err, "could not retrieve module from port-id")
 	}
 
+	// the acknowledgement cannot be written once the channel the original packet was received on is no longer
+	// open, so the funds of a failed forward are refunded in a refund packet instead. Otherwise, the funds were
+	// either delivered or moved to an account the user can access, and only the acknowledgement is skipped.
+	canWriteAck := k.canWriteAcknowledgement(ctx, inFlightPacket)
+	if !canWriteAck && !ack.Success() && !inFlightPacket.Nonrefundable {
+		return k.refundForward(ctx, packet, data, inFlightPacket, ack)
+	}
+
 	// for forwarded packets, the funds were moved into an escrow account if the denom originated on this chain.
 	// On an ack error or timeout on a forwarded packet, the funds in the escrow account
 	// should be moved to the other escrow account on the other side or burned.
@@ -201,16 +310,18 @@ func (k *Keeper) WriteAcknowledgementForForwardedPacket(
 			ackResult := fmt.Sprintf("packet forward failed after point of no return: %s", ack.GetError())
 			newAck := channeltypes.NewResultAcknowledgement([]byte(ackResult))
 
-			return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, channeltypes.Packet{
-				Data:               inFlightPacket.PacketData,
-				Sequence:           inFlightPacket.RefundSequence,
-				SourcePort:         inFlightPacket.PacketSrcPortId,
-				SourceChannel:      inFlightPacket.PacketSrcChannelId,
-				DestinationPort:    inFlightPacket.RefundPortId,
-				DestinationChannel: inFlightPacket.RefundChannelId,
-				TimeoutHeight:      clienttypes.MustParseHeight(inFlightPacket.PacketTimeoutHeight),
-				TimeoutTimestamp:   inFlightPacket.PacketTimeoutTimestamp,
-			}, newAck)
+			if err := k.recordReceipt(ctx, packet, inFlightPacket, ack); err != nil {
+				return err
+			}
+
+			if canWriteAck {
+				if err := k.writeOriginalAcknowledgement(ctx, chanCap, inFlightPacket, newAck); err != nil {
+					return err
+				}
+			}
+
+			k.afterForwardSettled(ctx, packet, inFlightPacket, ack)
+			return nil
 		}
 
 		fullDenomPath := data.Denom
@@ -243,6 +354,7 @@ func (k *Keeper) WriteAcknowledgementForForwardedPacket(
 
 				refundEscrowAddress := transfertypes.GetEscrowAddress(inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId)
 
+				// the funds remain in escrow, so the total escrow amount for the denom is unchanged.
 				if err := k.bankKeeper.SendCoins(
 					ctx, escrowAddress, refundEscrowAddress, sdk.NewCoins(token),
 				); err != nil {
@@ -265,14 +377,42 @@ func (k *Keeper) WriteAcknowledgementForForwardedPacket(
 					// to burn.
 					panic(fmt.Sprintf("cannot burn coins after a successful send from escrow account to module account: %v", err))
 				}
+
+				// update the total escrow amount for the denom.
+				k.unescrowToken(ctx, token)
 			}
+		}
+	}
 
-			// We move funds from the escrowAddress in both cases,
-			// update the total escrow amount for the denom.
-			k.unescrowToken(ctx, token)
+	if err := k.recordReceipt(ctx, packet, inFlightPacket, ack); err != nil {
+		return err
+	}
+
+	// the fee summary changes the format of the acknowledgement, so chains must opt in to it.
+	writtenAck := ack
+	if ack.Success() && k.GetParams(ctx).FeeSummaryAcks {
+		if writtenAck, err = feeSummaryAcknowledgement(ack, inFlightPacket); err != nil {
+			return err
+		}
+	}
+
+	if canWriteAck {
+		if err := k.writeOriginalAcknowledgement(ctx, chanCap, inFlightPacket, writtenAck); err != nil {
+			return err
 		}
 	}
 
+	k.afterForwardSettled(ctx, packet, inFlightPacket, ack)
+	return nil
+}
+
+// writeOriginalAcknowledgement writes the acknowledgement of the original packet of the in-flight packet.
+func (k *Keeper) writeOriginalAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	inFlightPacket *types.InFlightPacket,
+	ack channeltypes.Acknowledgement,
+) error {
 	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, channeltypes.Packet{
 		Data:               inFlightPacket.PacketData,
 		Sequence:           inFlightPacket.RefundSequence,
@@ -285,6 +425,13 @@ func (k *Keeper) WriteAcknowledgementForForwardedPacket(
 	}, ack)
 }
 
+// escrowToken will update the total escrow by adding the escrowed token to the current total escrow.
+func (k *Keeper) escrowToken(ctx sdk.Context, token sdk.Coin) {
+	currentTotalEscrow := k.transferKeeper.GetTotalEscrowForDenom(ctx, token.GetDenom())
+	newTotalEscrow := currentTotalEscrow.Add(token)
+	k.transferKeeper.SetTotalEscrowForDenom(ctx, newTotalEscrow)
+}
+
 // unescrowToken will update the total escrow by deducting the unescrowed token
 // from the current total escrow.
 func (k *Keeper) unescrowToken(ctx sdk.Context, token sdk.Coin) {
@@ -327,50 +474,69 @@ func (k *Keeper) ForwardTransferPacket(
 		}
 	}
 
-	memo := ""
-
-	// set memo for next transfer with next from this transfer.
-	if metadata.Next != nil {
-		memoBz, err := json.Marshal(metadata.Next)
-		if err != nil {
-			k.Logger(ctx).Error("packetForwardMiddleware error marshaling next as JSON",
-				"error", err,
-			)
-			return errorsmod.Wrapf(sdkerrors.ErrJSONMarshal, err.Error())
+	var relayerFee types.RelayerFee
+	if inFlightPacket == nil {
+		if metadata.RelayerFee != nil {
+			// relayer fees for the next hop are paid out of the forwarded amount.
+			relayerFee, err = metadata.RelayerFee.RelayerFee(token.Denom)
+			if err != nil {
+				return err
+			}
+			packetAmount = packetAmount.Sub(relayerFee.Total().AmountOf(token.Denom))
+			if !packetAmount.IsPositive() {
+				return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds,
+					"relayer fee %s exceeds forwarded amount %s", relayerFee.Total(), token)
+			}
+			packetCoin = sdk.NewCoin(token.Denom, packetAmount)
+		}
+	} else if inFlightPacket.RelayerFee != nil {
+		// the recv and ack fees of the timed out packet were refunded, so they incentivize the retry.
+		relayerFee = types.RelayerFee{
+			RecvFee: inFlightPacket.RelayerFee.RecvFee,
+			AckFee:  inFlightPacket.RelayerFee.AckFee,
 		}
-		memo = string(memoBz)
 	}
 
-	msgTransfer := transfertypes.NewMsgTransfer(
-		metadata.Port,
-		metadata.Channel,
-		packetCoin,
-		receiver,
-		metadata.Receiver,
-		DefaultTransferPacketTimeoutHeight,
-		uint64(ctx.BlockTime().UnixNano())+uint64(timeout.Nanoseconds()),
-		memo,
-	)
-
-	k.Logger(ctx).Debug("packetForwardMiddleware ForwardTransferPacket",
-		"port", metadata.Port, "channel", metadata.Channel,
-		"sender", receiver, "receiver", metadata.Receiver,
-		"amount", packetCoin.Amount.String(), "denom", packetCoin.Denom,
-	)
-
-	// send tokens to destination
-	res, err := k.transferKeeper.Transfer(
-		sdk.WrapSDKContext(ctx),
-		msgTransfer,
-	)
+	// set memo for next transfer with next and the final memo from this transfer.
+	memo, err := metadata.NextMemo()
 	if err != nil {
-		k.Logger(ctx).Error("packetForwardMiddleware ForwardTransferPacket error",
-			"port", metadata.Port, "channel", metadata.Channel,
-			"sender", receiver, "receiver", metadata.Receiver,
-			"amount", packetCoin.Amount.String(), "denom", packetCoin.Denom,
+		k.Logger(ctx).Error("packetForwardMiddleware error building memo of next transfer",
 			"error", err,
 		)
-		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+		return errorsmod.Wrapf(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	// the forward fails over to the equivalent channels of the channel if it cannot be sent over it.
+	var attempted []string
+	if inFlightPacket != nil {
+		attempted = inFlightPacket.AttemptedChannelIds
+	}
+	requestedChannel := metadata.Channel
+
+	var res *transfertypes.MsgTransferResponse
+	channels := k.forwardChannels(ctx, metadata.Port, requestedChannel, token.Denom, attempted)
+	if len(channels) == 0 {
+		return fmt.Errorf("no channel to forward over on port %s for channel %s", metadata.Port, requestedChannel)
+	}
+	for _, channel := range channels {
+		attempted = append(attempted, channel)
+		metadata.Channel = channel
+
+		if len(channels) == 1 {
+			res, err = k.sendForward(ctx, metadata, receiver, packetCoin, relayerFee, timeout, memo)
+			break
+		}
+
+		// nothing is written for a channel the forward cannot be sent over.
+		cacheCtx, writeCache := ctx.CacheContext()
+		res, err = k.sendForward(cacheCtx, metadata, receiver, packetCoin, relayerFee, timeout, memo)
+		if err == nil {
+			writeCache()
+			break
+		}
+	}
+	if err != nil {
+		return err
 	}
 
 	// Store the following information in keeper:
@@ -393,15 +559,34 @@ func (k *Keeper) ForwardTransferPacket(
 			RetriesRemaining: int32(maxRetries),
 			Timeout:          uint64(timeout.Nanoseconds()),
 			Nonrefundable:    nonrefundable,
+
+			AttemptedChannelIds: attempted,
+		}
+		if metadata.RetryPolicy != nil {
+			inFlightPacket.RetriableErrorCodes = metadata.RetryPolicy.ErrorCodes
+			inFlightPacket.AlternateChannelId = metadata.RetryPolicy.AlternateChannel
 		}
 	} else {
 		inFlightPacket.RetriesRemaining--
+		inFlightPacket.AttemptedChannelIds = attempted
 	}
 
-	key := types.RefundPacketKey(metadata.Channel, metadata.Port, res.Sequence)
-	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(inFlightPacket)
-	store.Set(key, bz)
+	inFlightPacket.ForwardToken = packetCoin
+	if feeAmount.IsPositive() {
+		inFlightPacket.Fees = inFlightPacket.Fees.Add(feeCoins...)
+	}
+	inFlightPacket.RelayerFee = nil
+	if !relayerFee.Total().IsZero() {
+		inFlightPacket.RelayerFee = &relayerFee
+	}
+
+	if err := k.setInFlightPacket(ctx, metadata.Channel, metadata.Port, res.Sequence, inFlightPacket); err != nil {
+		return err
+	}
+	k.afterForwardInitiated(ctx, metadata.Port, metadata.Channel, res.Sequence, inFlightPacket)
+	if metadata.Channel != requestedChannel {
+		emitForwardFailover(ctx, inFlightPacket, metadata.Port, requestedChannel, metadata.Channel, res.Sequence)
+	}
 
 	defer func() {
 		if token.Amount.IsInt64() {
@@ -421,42 +606,242 @@ func (k *Keeper) ForwardTransferPacket(
 	return nil
 }
 
+// sendForward sends the forwarded packet over the channel of the metadata, paying its relayer fee.
+func (k *Keeper) sendForward(
+	ctx sdk.Context,
+	metadata *types.ForwardMetadata,
+	receiver string,
+	packetCoin sdk.Coin,
+	relayerFee types.RelayerFee,
+	timeout time.Duration,
+	memo string,
+) (*transfertypes.MsgTransferResponse, error) {
+	if !relayerFee.Total().IsZero() {
+		if err := k.payRelayerFee(ctx, metadata.Port, metadata.Channel, receiver, relayerFee); err != nil {
+			k.Logger(ctx).Error("packetForwardMiddleware error paying relayer fee",
+				"port", metadata.Port, "channel", metadata.Channel,
+				"relayer-fee", relayerFee.Total().String(),
+				"error", err,
+			)
+			return nil, err
+		}
+	}
+
+	msgTransfer := transfertypes.NewMsgTransfer(
+		metadata.Port,
+		metadata.Channel,
+		packetCoin,
+		receiver,
+		metadata.Receiver,
+		DefaultTransferPacketTimeoutHeight,
+		uint64(ctx.BlockTime().UnixNano())+uint64(timeout.Nanoseconds()),
+		memo,
+	)
+
+	k.Logger(ctx).Debug("packetForwardMiddleware ForwardTransferPacket",
+		"port", metadata.Port, "channel", metadata.Channel,
+		"sender", receiver, "receiver", metadata.Receiver,
+		"amount", packetCoin.Amount.String(), "denom", packetCoin.Denom,
+	)
+
+	// send tokens to destination
+	res, err := k.transferKeeper.Transfer(
+		sdk.WrapSDKContext(ctx),
+		msgTransfer,
+	)
+	if err != nil {
+		k.Logger(ctx).Error("packetForwardMiddleware ForwardTransferPacket error",
+			"port", metadata.Port, "channel", metadata.Channel,
+			"sender", receiver, "receiver", metadata.Receiver,
+			"amount", packetCoin.Amount.String(), "denom", packetCoin.Denom,
+			"error", err,
+		)
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+
+	return res, nil
+}
+
+// payRelayerFee escrows the ICS-29 relayer fee for the next packet sent over the given channel.
+// The fee is paid by, and unused fees are refunded to, the forwarding account.
+func (k *Keeper) payRelayerFee(ctx sdk.Context, port, channel, payer string, relayerFee types.RelayerFee) error {
+	if k.feeKeeper == nil {
+		return types.ErrRelayerFeeUnsupported
+	}
+
+	fee := feetypes.NewFee(relayerFee.RecvFee, relayerFee.AckFee, relayerFee.TimeoutFee)
+	if _, err := k.feeKeeper.PayPacketFee(
+		sdk.WrapSDKContext(ctx),
+		feetypes.NewMsgPayPacketFee(fee, port, channel, payer, nil),
+	); err != nil {
+		return errorsmod.Wrap(types.ErrRelayerFeeUnsupported, err.Error())
+	}
+
+	return nil
+}
+
+// RefundUnusedRelayerFee moves the relayer fees that the fee middleware refunded to the forwarding account
+// once the forwarded packet was acknowledged or timed out. If refund is set, the fees are refunded together
+// with the forwarded tokens, otherwise they are moved to an account on this chain that the user can access.
+//
+// The fee middleware must wrap this middleware, so that the fees are distributed before this is called.
+func (k *Keeper) RefundUnusedRelayerFee(
+	ctx sdk.Context,
+	data transfertypes.FungibleTokenPacketData,
+	inFlightPacket *types.InFlightPacket,
+	timedOut bool,
+	refund bool,
+) error {
+	if inFlightPacket.RelayerFee == nil {
+		return nil
+	}
+
+	unused := inFlightPacket.RelayerFee.Unused(timedOut)
+	if unused.IsZero() {
+		return nil
+	}
+
+	forwarder, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return fmt.Errorf("failed to decode forwarding account for relayer fee refund: %w", err)
+	}
+
+	// the fees are not refunded together with the forwarded tokens if these are refunded in a refund packet.
+	if !refund || inFlightPacket.Nonrefundable || !k.canWriteAcknowledgement(ctx, inFlightPacket) {
+		userAccount, err := userRecoverableAccount(inFlightPacket)
+		if err != nil {
+			return fmt.Errorf("failed to get user recoverable account: %w", err)
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, forwarder, userAccount, unused); err != nil {
+			return fmt.Errorf("failed to send unused relayer fee to user recoverable account: %w", err)
+		}
+		return nil
+	}
+
+	for _, coin := range unused {
+		fullDenomPath := coin.Denom
+		if strings.HasPrefix(coin.Denom, "ibc/") {
+			fullDenomPath, err = k.transferKeeper.DenomPathFromHash(ctx, coin.Denom)
+			if err != nil {
+				return err
+			}
+		}
+
+		if transfertypes.SenderChainIsSource(inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId, fullDenomPath) {
+			// the tokens were unescrowed when received, so they are escrowed again for the refund.
+			refundEscrowAddress := transfertypes.GetEscrowAddress(inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId)
+			if err := k.bankKeeper.SendCoins(ctx, forwarder, refundEscrowAddress, sdk.NewCoins(coin)); err != nil {
+				return fmt.Errorf("failed to send unused relayer fee to refund escrow account: %w", err)
+			}
+
+			// update the total escrow amount for the denom.
+			k.escrowToken(ctx, coin)
+			continue
+		}
+
+		// the vouchers were minted when received, so they are burned for the refund.
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(
+			ctx, forwarder, transfertypes.ModuleName, sdk.NewCoins(coin),
+		); err != nil {
+			return fmt.Errorf("failed to send unused relayer fee to module account for burn: %w", err)
+		}
+
+		if err := k.bankKeeper.BurnCoins(ctx, transfertypes.ModuleName, sdk.NewCoins(coin)); err != nil {
+			panic(fmt.Sprintf("cannot burn coins after a successful send to module account: %v", err))
+		}
+	}
+
+	return nil
+}
+
 // TimeoutShouldRetry returns inFlightPacket and no error if retry should be attempted. Error is returned if IBC refund should occur.
 func (k *Keeper) TimeoutShouldRetry(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 ) (*types.InFlightPacket, error) {
-	store := ctx.KVStore(k.storeKey)
-	key := types.RefundPacketKey(packet.SourceChannel, packet.SourcePort, packet.Sequence)
-
-	if !store.Has(key) {
+	inFlightPacket := k.getInFlightPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence)
+	if inFlightPacket == nil {
 		// not a forwarded packet, ignore.
 		return nil, nil
 	}
 
-	bz := store.Get(key)
-	var inFlightPacket types.InFlightPacket
-	k.cdc.MustUnmarshal(bz, &inFlightPacket)
-
 	if inFlightPacket.RetriesRemaining <= 0 {
 		k.Logger(ctx).Error("packetForwardMiddleware reached max retries for packet",
-			"key", string(key),
+			"key", string(types.RefundPacketKey(packet.SourceChannel, packet.SourcePort, packet.Sequence)),
 			"original-sender-address", inFlightPacket.OriginalSenderAddress,
 			"refund-channel-id", inFlightPacket.RefundChannelId,
 			"refund-port-id", inFlightPacket.RefundPortId,
 		)
-		return &inFlightPacket, fmt.Errorf("giving up on packet on channel (%s) port (%s) after max retries",
+		return inFlightPacket, fmt.Errorf("giving up on packet on channel (%s) port (%s) after max retries",
 			inFlightPacket.RefundChannelId, inFlightPacket.RefundPortId)
 	}
 
-	return &inFlightPacket, nil
+	// the timeout closes an ordered channel, and a packet timed out on close was sent over a closed channel, so the
+	// retry could never be received.
+	channel, found := k.channelKeeper.GetChannel(ctx, packet.SourcePort, packet.SourceChannel)
+	if found && (channel.Ordering == channeltypes.ORDERED || channel.State == channeltypes.CLOSED) {
+		k.Logger(ctx).Error("packetForwardMiddleware not retrying packet timed out on ordered or closed channel",
+			"key", string(types.RefundPacketKey(packet.SourceChannel, packet.SourcePort, packet.Sequence)),
+			"original-sender-address", inFlightPacket.OriginalSenderAddress,
+			"refund-channel-id", inFlightPacket.RefundChannelId,
+			"refund-port-id", inFlightPacket.RefundPortId,
+		)
+		return inFlightPacket, fmt.Errorf("giving up on packet timed out on ordered or closed channel (%s) port (%s)",
+			packet.SourceChannel, packet.SourcePort)
+	}
+
+	return inFlightPacket, nil
+}
+
+// ErrorAckShouldRetry returns true if the error acknowledgement of a forwarded packet should be retried, because
+// its error is retriable according to the retry policy of the forward and retries are left.
+func (k *Keeper) ErrorAckShouldRetry(inFlightPacket *types.InFlightPacket, ack channeltypes.Acknowledgement) bool {
+	if ack.Success() || inFlightPacket.RetriesRemaining <= 0 {
+		return false
+	}
+	return inFlightPacket.IsRetriableError(ack.GetError())
 }
 
+// RetryTimeout retries the forward of a forwarded packet that timed out.
 func (k *Keeper) RetryTimeout(
 	ctx sdk.Context,
 	channel, port string,
 	data transfertypes.FungibleTokenPacketData,
 	inFlightPacket *types.InFlightPacket,
+) error {
+	return k.RetryForward(ctx, channel, port, data, inFlightPacket)
+}
+
+// RetryErrorAck retries the forward of a forwarded packet that was acknowledged with a retriable error, over the
+// alternate channel of its retry policy if it has one. The relayer fees of the forwarded packet were paid, so the
+// retry is sent without relayer fees and the unused timeout fee is moved to an account on this chain that the
+// user can access. The in-flight packet is copied, so that it is unchanged if the retry fails.
+func (k *Keeper) RetryErrorAck(
+	ctx sdk.Context,
+	channel, port string,
+	data transfertypes.FungibleTokenPacketData,
+	inFlightPacket types.InFlightPacket,
+) error {
+	if err := k.RefundUnusedRelayerFee(ctx, data, &inFlightPacket, false, false); err != nil {
+		return err
+	}
+	inFlightPacket.RelayerFee = nil
+
+	if inFlightPacket.AlternateChannelId != "" {
+		channel = inFlightPacket.AlternateChannelId
+	}
+
+	return k.RetryForward(ctx, channel, port, data, &inFlightPacket)
+}
+
+// RetryForward sends the forward of a forwarded packet again over the channel, or one of its equivalent channels.
+// The tokens of the forwarded packet must have been refunded to the forwarding account.
+func (k *Keeper) RetryForward(
+	ctx sdk.Context,
+	channel, port string,
+	data transfertypes.FungibleTokenPacketData,
+	inFlightPacket *types.InFlightPacket,
 ) error {
 	// send transfer again
 	metadata := &types.ForwardMetadata{
@@ -505,15 +890,14 @@ func (k *Keeper) RetryTimeout(
 }
 
 func (k *Keeper) RemoveInFlightPacket(ctx sdk.Context, packet channeltypes.Packet) {
-	store := ctx.KVStore(k.storeKey)
-	key := types.RefundPacketKey(packet.SourceChannel, packet.SourcePort, packet.Sequence)
-	if !store.Has(key) {
+	inFlightPacket := k.getInFlightPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence)
+	if inFlightPacket == nil {
 		// not a forwarded packet, ignore.
 		return
 	}
 
 	// done with packet key now, delete.
-	store.Delete(key)
+	k.deleteInFlightPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence)
 }
 
 // GetAndClearInFlightPacket will fetch an InFlightPacket from the store, remove it if it exists, and return it.
@@ -523,21 +907,24 @@ func (k *Keeper) GetAndClearInFlightPacket(
 	port string,
 	sequence uint64,
 ) *types.InFlightPacket {
-	store := ctx.KVStore(k.storeKey)
-	key := types.RefundPacketKey(channel, port, sequence)
-	if !store.Has(key) {
+	inFlightPacket := k.getInFlightPacket(ctx, channel, port, sequence)
+	if inFlightPacket == nil {
 		// this is either not a forwarded packet, or it is the final destination for the refund.
 		return nil
 	}
 
-	bz := store.Get(key)
-
 	// done with packet key now, delete.
-	store.Delete(key)
+	k.deleteInFlightPacket(ctx, channel, port, sequence)
 
-	var inFlightPacket types.InFlightPacket
-	k.cdc.MustUnmarshal(bz, &inFlightPacket)
-	return &inFlightPacket
+	return inFlightPacket
+}
+
+// IterateInFlightPackets iterates over all in-flight packets and calls cb with the key and value of each one.
+// Iteration stops when cb returns true.
+func (k *Keeper) IterateInFlightPackets(ctx sdk.Context, cb func(key types.InFlightPacketKey, inFlightPacket types.InFlightPacket) (stop bool)) {
+	if err := k.walkInFlightPackets(ctx, nil, cb); err != nil {
+		panic(err)
+	}
 }
 
 // SendPacket wraps IBC ChannelKeeper's SendPacket function
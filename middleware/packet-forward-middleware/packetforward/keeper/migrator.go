@@ -3,6 +3,9 @@ package keeper
 import (
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/exported"
 	v2 "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/migrations/v2"
+	v3 "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/migrations/v3"
+	v4 "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/migrations/v4"
+	v5 "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/migrations/v5"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -27,3 +30,29 @@ func NewMigrator(k *Keeper, ss exported.Subspace) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v2.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.legacySubspace, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates the module state from the consensus version 2 to
+// version 3. Specifically, it moves the in-flight packets under a store
+// prefix, indexes them by original sender and by refund channel, and sets the
+// gas consumed per memo byte to its default.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
+
+// Migrate3to4 migrates the module state from the consensus version 3 to
+// version 4. Specifically, it sets the retries and timeouts of forwards in the
+// module parameters to the values the middleware was constructed with, see
+// SetLegacyForwardLimits.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.Migrate(
+		ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc,
+		m.keeper.legacyRetriesOnTimeout, m.keeper.legacyForwardTimeout, m.keeper.legacyRefundTimeout,
+	)
+}
+
+// Migrate4to5 migrates the module state from the consensus version 4 to
+// version 5. Specifically, it moves the in-flight packets and their indexes
+// into the in-flight packet collection.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc, m.keeper.inFlightPackets)
+}
@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -37,3 +38,72 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SetForwardingPaused implements types.MsgServer.
+func (ms msgServer) SetForwardingPaused(goCtx context.Context, req *types.MsgSetForwardingPaused) (*types.MsgSetForwardingPausedResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.Keeper.SetForwardingPaused(ctx, req.Paused, req.ChannelIds, req.Denoms); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetForwardingPausedResponse{}, nil
+}
+
+// SweepIntermediateAccount implements types.MsgServer.
+func (ms msgServer) SweepIntermediateAccount(
+	goCtx context.Context,
+	req *types.MsgSweepIntermediateAccount,
+) (*types.MsgSweepIntermediateAccountResponse, error) {
+	// only the original sender or governance may decide where the funds of the original sender go.
+	if req.Signer != ms.authority {
+		signer, err := sdk.AccAddressFromBech32(req.Signer)
+		if err != nil {
+			return nil, err
+		}
+		recoverableAccount, err := RecoverableAccount(req.OriginalSender)
+		if err != nil || !signer.Equals(recoverableAccount) {
+			return nil, errors.Wrapf(sdkerrors.ErrUnauthorized,
+				"signer %s is neither the account of original sender %s nor the authority", req.Signer, req.OriginalSender)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	swept, err := ms.Keeper.SweepIntermediateAccount(ctx, req.PortId, req.ChannelId, req.OriginalSender, req.ToRecoverableAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSweepIntermediateAccountResponse{Swept: swept}, nil
+}
+
+// SetRoute implements types.MsgServer.
+func (ms msgServer) SetRoute(goCtx context.Context, req *types.MsgSetRoute) (*types.MsgSetRouteResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.Keeper.SetRoute(ctx, req.Route); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetRouteResponse{}, nil
+}
+
+// RemoveRoute implements types.MsgServer.
+func (ms msgServer) RemoveRoute(goCtx context.Context, req *types.MsgRemoveRoute) (*types.MsgRemoveRouteResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.Keeper.RemoveRoute(ctx, req.Name); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveRouteResponse{}, nil
+}
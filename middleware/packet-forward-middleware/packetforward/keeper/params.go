@@ -1,8 +1,12 @@
 package keeper
 
 import (
+	"errors"
+
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
 
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -12,23 +16,18 @@ func (k Keeper) SetParams(ctx sdk.Context, p types.Params) error {
 		return err
 	}
 
-	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&p)
-	store.Set(types.ParamsKey, bz)
-	return nil
+	return k.params.Set(ctx, p)
 }
 
 // GetParams returns the current module parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	var p types.Params
-
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.ParamsKey)
-	if bz == nil {
-		return p
+	p, err := k.params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Params{}
+	}
+	if err != nil {
+		panic(err)
 	}
-
-	k.cdc.MustUnmarshal(bz, &p)
 	return p
 }
 
@@ -36,3 +35,19 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 func (k Keeper) GetFeePercentage(ctx sdk.Context) sdk.Dec {
 	return k.GetParams(ctx).FeePercentage
 }
+
+// CheckForwardToken returns an error if the token may not be forwarded, because its denom is not
+// allowed or is blocked, or its amount is below the minimum forward amount of the denom.
+func (k Keeper) CheckForwardToken(ctx sdk.Context, token sdk.Coin) error {
+	params := k.GetParams(ctx)
+
+	if !params.IsDenomForwardable(token.Denom) {
+		return types.ErrDenomNotForwardable.Wrapf("denom %s", token.Denom)
+	}
+
+	if minAmount := params.MinForwardAmounts.AmountOf(token.Denom); token.Amount.LT(minAmount) {
+		return types.ErrForwardAmountTooLow.Wrapf("%s is less than %s%s", token, minAmount, token.Denom)
+	}
+
+	return nil
+}
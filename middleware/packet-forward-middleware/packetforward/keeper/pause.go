@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetPauseState sets which new forwards are halted.
+func (k Keeper) SetPauseState(ctx sdk.Context, ps types.PauseState) error {
+	if err := ps.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&ps)
+	store.Set(types.PauseStateKey, bz)
+	return nil
+}
+
+// GetPauseState returns which new forwards are halted.
+func (k Keeper) GetPauseState(ctx sdk.Context) types.PauseState {
+	var ps types.PauseState
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PauseStateKey)
+	if bz == nil {
+		return ps
+	}
+
+	k.cdc.MustUnmarshal(bz, &ps)
+	return ps
+}
+
+// SetForwardingPaused halts or resumes new forwards for the given channels and denoms,
+// or globally if none are given.
+func (k Keeper) SetForwardingPaused(ctx sdk.Context, paused bool, channelIDs []string, denoms []string) error {
+	return k.SetPauseState(ctx, k.GetPauseState(ctx).Update(paused, channelIDs, denoms))
+}
+
+// CheckForwardingPaused returns an error if a new forward of the denom from the source channel
+// over the forward channel is halted.
+func (k Keeper) CheckForwardingPaused(ctx sdk.Context, srcChannel, fwdChannel, denom string) error {
+	ps := k.GetPauseState(ctx)
+
+	switch {
+	case ps.Global:
+		return types.ErrForwardingPaused
+	case ps.IsChannelPaused(srcChannel):
+		return types.ErrForwardingPaused.Wrapf("channel %s", srcChannel)
+	case ps.IsChannelPaused(fwdChannel):
+		return types.ErrForwardingPaused.Wrapf("channel %s", fwdChannel)
+	case ps.IsDenomPaused(denom):
+		return types.ErrForwardingPaused.Wrapf("denom %s", denom)
+	}
+
+	return nil
+}
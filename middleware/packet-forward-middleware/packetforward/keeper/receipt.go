@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+)
+
+// recordReceipt records the forward receipt of the original packet of an in-flight packet that is acknowledged
+// with the acknowledgement of the forwarded packet. Nothing is recorded if forward receipts are disabled.
+func (k *Keeper) recordReceipt(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	inFlightPacket *types.InFlightPacket,
+	ack channeltypes.Acknowledgement,
+) error {
+	if k.GetParams(ctx).ReceiptRetentionBlocks == 0 {
+		return nil
+	}
+
+	receipt := types.ForwardReceipt{
+		OriginalSender:   inFlightPacket.OriginalSenderAddress,
+		PortId:           inFlightPacket.RefundPortId,
+		ChannelId:        inFlightPacket.RefundChannelId,
+		Sequence:         inFlightPacket.RefundSequence,
+		ForwardPortId:    packet.SourcePort,
+		ForwardChannelId: packet.SourceChannel,
+		ForwardSequence:  packet.Sequence,
+		Outcome:          types.ForwardOutcomeSuccess,
+		Token:            sdk.Coin{Denom: inFlightPacket.ForwardToken.Denom, Amount: sdk.ZeroInt()},
+		ForwardToken:     inFlightPacket.ForwardToken,
+		Fees:             inFlightPacket.Fees,
+		RelayerFee:       inFlightPacket.RelayerFee,
+		Height:           ctx.BlockHeight(),
+	}
+
+	// the received amount is denominated like the forwarded token, which is the token received on this chain.
+	var originalData transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(inFlightPacket.PacketData, &originalData); err == nil {
+		if amount, ok := sdk.NewIntFromString(originalData.Amount); ok {
+			receipt.Token.Amount = amount
+		}
+	}
+
+	if !ack.Success() {
+		receipt.Outcome = types.ForwardOutcomeRefunded
+		receipt.Error = ack.GetError()
+
+		if inFlightPacket.Nonrefundable {
+			userAccount, err := userRecoverableAccount(inFlightPacket)
+			if err != nil {
+				return err
+			}
+			receipt.Outcome = types.ForwardOutcomeRecovered
+			receipt.RecoveryAddress = userAccount.String()
+		}
+	}
+
+	k.setReceipt(ctx, receipt)
+	return nil
+}
+
+// setReceipt stores a forward receipt and indexes it by original sender and by the height it was recorded at,
+// replacing the receipt of the same original packet.
+func (k *Keeper) setReceipt(ctx sdk.Context, receipt types.ForwardReceipt) {
+	if existing, found := k.GetReceipt(ctx, receipt.PortId, receipt.ChannelId, receipt.Sequence); found {
+		k.deleteReceipt(ctx, existing)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ReceiptKey(receipt.PortId, receipt.ChannelId, receipt.Sequence), k.cdc.MustMarshal(&receipt))
+	store.Set(types.ReceiptBySenderKey(receipt.OriginalSender, receipt.PortId, receipt.ChannelId, receipt.Sequence), []byte{})
+	store.Set(types.ReceiptByHeightKey(receipt.Height, receipt.PortId, receipt.ChannelId, receipt.Sequence), []byte{})
+}
+
+// GetReceipt returns the forward receipt of the original packet received on the given port and channel.
+func (k *Keeper) GetReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) (types.ForwardReceipt, bool) {
+	var receipt types.ForwardReceipt
+
+	bz := ctx.KVStore(k.storeKey).Get(types.ReceiptKey(portID, channelID, sequence))
+	if bz == nil {
+		return receipt, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &receipt)
+	return receipt, true
+}
+
+// deleteReceipt removes a forward receipt and its index entries.
+func (k *Keeper) deleteReceipt(ctx sdk.Context, receipt types.ForwardReceipt) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ReceiptKey(receipt.PortId, receipt.ChannelId, receipt.Sequence))
+	store.Delete(types.ReceiptBySenderKey(receipt.OriginalSender, receipt.PortId, receipt.ChannelId, receipt.Sequence))
+	store.Delete(types.ReceiptByHeightKey(receipt.Height, receipt.PortId, receipt.ChannelId, receipt.Sequence))
+}
+
+// receiptEntry returns the forward receipt of the original packet encoded in the given refund packet key.
+func (k *Keeper) receiptEntry(ctx sdk.Context, key []byte) (types.ForwardReceipt, error) {
+	channelID, portID, sequence, err := types.ParseRefundPacketKey(key)
+	if err != nil {
+		return types.ForwardReceipt{}, err
+	}
+
+	receipt, found := k.GetReceipt(ctx, portID, channelID, sequence)
+	if !found {
+		return types.ForwardReceipt{}, types.ErrReceiptNotFound.Wrapf("indexed key %s", key)
+	}
+
+	return receipt, nil
+}
+
+// IterateReceipts iterates over the forward receipts in order of their original packets.
+func (k *Keeper) IterateReceipts(ctx sdk.Context, cb func(receipt types.ForwardReceipt) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReceiptKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var receipt types.ForwardReceipt
+		k.cdc.MustUnmarshal(iterator.Value(), &receipt)
+		if cb(receipt) {
+			break
+		}
+	}
+}
+
+// GetAllReceipts returns the forward receipts in order of their original packets.
+func (k *Keeper) GetAllReceipts(ctx sdk.Context) []types.ForwardReceipt {
+	var receipts []types.ForwardReceipt
+	k.IterateReceipts(ctx, func(receipt types.ForwardReceipt) bool {
+		receipts = append(receipts, receipt)
+		return false
+	})
+	return receipts
+}
+
+// PruneReceipts removes the forward receipts that were recorded at least the receipt retention blocks ago. All
+// receipts are removed once forward receipts are disabled.
+func (k *Keeper) PruneReceipts(ctx sdk.Context) {
+	retentionBlocks := k.GetParams(ctx).ReceiptRetentionBlocks
+	if retentionBlocks > uint64(ctx.BlockHeight()) {
+		return
+	}
+
+	// receipts recorded at heights up to and including the cutoff have expired.
+	cutoff := ctx.BlockHeight() - int64(retentionBlocks)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.ReceiptByHeightKeyPrefix, types.ReceiptByHeightPrefix(cutoff+1))
+	defer iterator.Close()
+
+	var expired [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		expired = append(expired, iterator.Key()[len(types.ReceiptByHeightPrefix(0)):])
+	}
+
+	for _, key := range expired {
+		receipt, err := k.receiptEntry(ctx, key)
+		if err != nil {
+			panic(err)
+		}
+		k.deleteReceipt(ctx, receipt)
+	}
+}
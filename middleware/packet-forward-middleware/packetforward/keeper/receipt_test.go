@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func newReceipt(sender, channelID string, sequence uint64, height int64) types.ForwardReceipt {
+	return types.ForwardReceipt{
+		OriginalSender:   sender,
+		PortId:           "transfer",
+		ChannelId:        channelID,
+		Sequence:         sequence,
+		ForwardPortId:    "transfer",
+		ForwardChannelId: "channel-9",
+		ForwardSequence:  sequence,
+		Outcome:          types.ForwardOutcomeSuccess,
+		Token:            sdk.NewInt64Coin("uatom", 100),
+		ForwardToken:     sdk.NewInt64Coin("uatom", 90),
+		Fees:             sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)),
+		Height:           height,
+	}
+}
+
+func TestQueryReceipts(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	goCtx := sdk.WrapSDKContext(ctx)
+	pfmKeeper := setup.Keepers.PacketForwardKeeper
+
+	const (
+		sender      = "cosmos1wnlew8ss0sqclfalvj6jkcyvnwq79fd74qxxue"
+		otherSender = "osmo1wnlew8ss0sqclfalvj6jkcyvnwq79fd7mz3qxc"
+	)
+
+	genesis := types.DefaultGenesisState()
+	genesis.Receipts = []types.ForwardReceipt{
+		newReceipt(sender, "channel-0", 1, 1),
+		newReceipt(sender, "channel-0", 2, 1),
+		newReceipt(otherSender, "channel-1", 1, 1),
+	}
+	require.NoError(t, genesis.Validate())
+	pfmKeeper.InitGenesis(ctx, *genesis)
+
+	receipt, err := pfmKeeper.Receipt(goCtx, &types.QueryReceiptRequest{PortId: "transfer", ChannelId: "channel-0", Sequence: 2})
+	require.NoError(t, err)
+	require.Equal(t, genesis.Receipts[1], receipt.Receipt)
+
+	_, err = pfmKeeper.Receipt(goCtx, &types.QueryReceiptRequest{PortId: "transfer", ChannelId: "channel-0", Sequence: 3})
+	require.Error(t, err)
+
+	bySender, err := pfmKeeper.ReceiptsBySender(goCtx, &types.QueryReceiptsBySenderRequest{
+		OriginalSender: sender,
+		Pagination:     &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, genesis.Receipts[:1], bySender.Receipts)
+	require.Equal(t, uint64(2), bySender.Pagination.Total)
+
+	_, err = pfmKeeper.ReceiptsBySender(goCtx, &types.QueryReceiptsBySenderRequest{})
+	require.Error(t, err)
+
+	require.Equal(t, genesis.Receipts, pfmKeeper.ExportGenesis(ctx).Receipts)
+}
+
+func TestPruneReceipts(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	pfmKeeper := setup.Keepers.PacketForwardKeeper
+
+	const sender = "cosmos1wnlew8ss0sqclfalvj6jkcyvnwq79fd74qxxue"
+
+	params := types.DefaultParams()
+	params.ReceiptRetentionBlocks = 10
+	genesis := types.DefaultGenesisState()
+	genesis.Params = params
+	genesis.Receipts = []types.ForwardReceipt{
+		newReceipt(sender, "channel-0", 1, 5),
+		newReceipt(sender, "channel-0", 2, 10),
+		newReceipt(sender, "channel-0", 3, 11),
+	}
+	pfmKeeper.InitGenesis(ctx, *genesis)
+
+	// receipts are retained for the retention blocks after the height they were recorded at.
+	pfmKeeper.PruneReceipts(ctx.WithBlockHeight(9))
+	require.Len(t, pfmKeeper.GetAllReceipts(ctx), 3)
+
+	pfmKeeper.PruneReceipts(ctx.WithBlockHeight(20))
+	require.Equal(t, genesis.Receipts[2:], pfmKeeper.GetAllReceipts(ctx))
+
+	res, err := pfmKeeper.ReceiptsBySender(sdk.WrapSDKContext(ctx), &types.QueryReceiptsBySenderRequest{OriginalSender: sender})
+	require.NoError(t, err)
+	require.Equal(t, genesis.Receipts[2:], res.Receipts)
+
+	// all receipts are pruned once receipts are disabled.
+	require.NoError(t, pfmKeeper.SetParams(ctx, types.DefaultParams()))
+	pfmKeeper.PruneReceipts(ctx.WithBlockHeight(21))
+	require.Empty(t, pfmKeeper.GetAllReceipts(ctx))
+}
@@ -0,0 +1,232 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+)
+
+// canWriteAcknowledgement returns true if the acknowledgement of the original packet of the in-flight packet can be
+// written, which requires the channel the original packet was received on to be open and not closed by the
+// timeout of an ordered channel being handled.
+func (k *Keeper) canWriteAcknowledgement(ctx sdk.Context, inFlightPacket *types.InFlightPacket) bool {
+	return k.isChannelOpen(ctx, inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId)
+}
+
+// refundForward refunds the funds of a failed forward to the original sender in a refund packet, for an original
+// packet whose error acknowledgement cannot be written. The funds are moved to the intermediate account of the
+// original sender, which sends the refund packet back to the chain the original packet came from.
+func (k *Keeper) refundForward(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	inFlightPacket *types.InFlightPacket,
+	ack channeltypes.Acknowledgement,
+) error {
+	intermediateAccount := types.IntermediateAccount(inFlightPacket.RefundChannelId, inFlightPacket.OriginalSenderAddress)
+
+	token, err := k.moveFundsToAccount(ctx, packet, data, intermediateAccount)
+	if err != nil {
+		return err
+	}
+
+	refund := types.PendingRefund{
+		OriginalSenderAddress: inFlightPacket.OriginalSenderAddress,
+		RefundPortId:          inFlightPacket.RefundPortId,
+		RefundChannelId:       inFlightPacket.RefundChannelId,
+		RefundSequence:        inFlightPacket.RefundSequence,
+		Token:                 token,
+		RetriesRemaining:      int32(k.GetParams(ctx).MaxRetries),
+		Error:                 ack.GetError(),
+	}
+	if err := k.sendRefund(ctx, &refund); err != nil {
+		return err
+	}
+
+	if err := k.recordReceipt(ctx, packet, inFlightPacket, ack); err != nil {
+		return err
+	}
+
+	k.afterForwardSettled(ctx, packet, inFlightPacket, ack)
+	return nil
+}
+
+// sendRefund sends the refund packet of the pending refund from the intermediate account of the original sender.
+// The refund is sent over the open channels among the channel the original packet was received on and its
+// equivalent channels, and is tracked until the refund packet is acknowledged. If it cannot be sent over any
+// channel, the funds are moved to an account of the original sender on this chain instead.
+func (k *Keeper) sendRefund(ctx sdk.Context, refund *types.PendingRefund) error {
+	intermediateAccount := types.IntermediateAccount(refund.RefundChannelId, refund.OriginalSenderAddress)
+	timeout := uint64(ctx.BlockTime().UnixNano()) + uint64(k.GetParams(ctx).RefundTimeout.Nanoseconds())
+
+	channels := k.forwardChannels(ctx, refund.RefundPortId, refund.RefundChannelId, refund.Token.Denom, refund.AttemptedChannelIds)
+	for _, channel := range channels {
+		// a refund is only sent when the acknowledgement cannot be written, so the channel the original packet was
+		// received on usually is not open.
+		if !k.isChannelOpen(ctx, refund.RefundPortId, channel) {
+			continue
+		}
+		refund.AttemptedChannelIds = append(refund.AttemptedChannelIds, channel)
+
+		// nothing is written for a channel the refund cannot be sent over.
+		cacheCtx, writeCache := ctx.CacheContext()
+		res, err := k.transferKeeper.Transfer(sdk.WrapSDKContext(cacheCtx), transfertypes.NewMsgTransfer(
+			refund.RefundPortId,
+			channel,
+			refund.Token,
+			intermediateAccount.String(),
+			refund.OriginalSenderAddress,
+			DefaultTransferPacketTimeoutHeight,
+			timeout,
+			"",
+		))
+		if err != nil {
+			k.Logger(ctx).Error("packetForwardMiddleware error sending refund packet",
+				"port", refund.RefundPortId, "channel", channel,
+				"receiver", refund.OriginalSenderAddress,
+				"amount", refund.Token.String(),
+				"error", err,
+			)
+			continue
+		}
+		writeCache()
+
+		refund.PortId = refund.RefundPortId
+		refund.ChannelId = channel
+		refund.Sequence = res.Sequence
+		k.setPendingRefund(ctx, *refund)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRefundPacket,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyRefundPortID, refund.RefundPortId),
+				sdk.NewAttribute(types.AttributeKeyRefundChannelID, refund.RefundChannelId),
+				sdk.NewAttribute(types.AttributeKeyRefundSequence, strconv.FormatUint(refund.RefundSequence, 10)),
+				sdk.NewAttribute(types.AttributeKeyRefundPacketPortID, refund.PortId),
+				sdk.NewAttribute(types.AttributeKeyRefundPacketChannelID, refund.ChannelId),
+				sdk.NewAttribute(types.AttributeKeyRefundPacketSequence, strconv.FormatUint(refund.Sequence, 10)),
+				sdk.NewAttribute(types.AttributeKeyOriginalSender, refund.OriginalSenderAddress),
+				sdk.NewAttribute(types.AttributeKeyAmount, refund.Token.String()),
+				sdk.NewAttribute(types.AttributeKeyRetriesRemaining, strconv.FormatInt(int64(refund.RetriesRemaining), 10)),
+				sdk.NewAttribute(types.AttributeKeyAttemptedChannelIDs, strings.Join(refund.AttemptedChannelIds, ",")),
+			),
+		)
+		return nil
+	}
+
+	return k.recoverRefund(ctx, *refund)
+}
+
+// OnRefundPacketSettled handles the acknowledgement or timeout of the refund packet of a pending refund, once the
+// transfer module refunded the funds of a failed refund packet to the intermediate account. A failed refund is
+// sent again while it has retries remaining, and its funds are moved to an account of the original sender on this
+// chain otherwise.
+func (k *Keeper) OnRefundPacketSettled(ctx sdk.Context, refund types.PendingRefund, success bool) error {
+	k.deletePendingRefund(ctx, refund.PortId, refund.ChannelId, refund.Sequence)
+	if success {
+		return nil
+	}
+
+	if refund.RetriesRemaining <= 0 {
+		return k.recoverRefund(ctx, refund)
+	}
+
+	refund.RetriesRemaining--
+	return k.sendRefund(ctx, &refund)
+}
+
+// recoverRefund moves the funds of a refund that cannot be sent from the intermediate account to the account of
+// the original sender on this chain. If the original sender is not a bech32 address, the funds stay on the
+// intermediate account, from which they can be swept.
+func (k *Keeper) recoverRefund(ctx sdk.Context, refund types.PendingRefund) error {
+	intermediateAccount := types.IntermediateAccount(refund.RefundChannelId, refund.OriginalSenderAddress)
+
+	_, senderBz, err := bech32.DecodeAndConvert(refund.OriginalSenderAddress)
+	if err != nil {
+		k.Logger(ctx).Error("packetForwardMiddleware error recovering refund, leaving funds on intermediate account",
+			"intermediate-account", intermediateAccount.String(),
+			"original-sender", refund.OriginalSenderAddress,
+			"amount", refund.Token.String(),
+			"error", err,
+		)
+		return nil
+	}
+	recoverableAccount := sdk.AccAddress(senderBz)
+
+	if err := k.bankKeeper.SendCoins(ctx, intermediateAccount, recoverableAccount, sdk.NewCoins(refund.Token)); err != nil {
+		return fmt.Errorf("failed to send refund to recoverable account: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundRecovered,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyRefundPortID, refund.RefundPortId),
+			sdk.NewAttribute(types.AttributeKeyRefundChannelID, refund.RefundChannelId),
+			sdk.NewAttribute(types.AttributeKeyRefundSequence, strconv.FormatUint(refund.RefundSequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyOriginalSender, refund.OriginalSenderAddress),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recoverableAccount.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, refund.Token.String()),
+		),
+	)
+	return nil
+}
+
+// setPendingRefund stores a pending refund, keyed by its refund packet.
+func (k *Keeper) setPendingRefund(ctx sdk.Context, refund types.PendingRefund) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PendingRefundKey(refund.PortId, refund.ChannelId, refund.Sequence), k.cdc.MustMarshal(&refund))
+}
+
+// GetPendingRefund returns the pending refund of the refund packet sent over the given port and channel.
+func (k *Keeper) GetPendingRefund(ctx sdk.Context, portID, channelID string, sequence uint64) (types.PendingRefund, bool) {
+	var refund types.PendingRefund
+
+	bz := ctx.KVStore(k.storeKey).Get(types.PendingRefundKey(portID, channelID, sequence))
+	if bz == nil {
+		return refund, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &refund)
+	return refund, true
+}
+
+// deletePendingRefund removes the pending refund of a refund packet.
+func (k *Keeper) deletePendingRefund(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.PendingRefundKey(portID, channelID, sequence))
+}
+
+// IteratePendingRefunds iterates over the pending refunds in order of their refund packets.
+func (k *Keeper) IteratePendingRefunds(ctx sdk.Context, cb func(refund types.PendingRefund) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingRefundKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var refund types.PendingRefund
+		k.cdc.MustUnmarshal(iterator.Value(), &refund)
+		if cb(refund) {
+			break
+		}
+	}
+}
+
+// GetAllPendingRefunds returns the pending refunds in order of their refund packets.
+func (k *Keeper) GetAllPendingRefunds(ctx sdk.Context) []types.PendingRefund {
+	var refunds []types.PendingRefund
+	k.IteratePendingRefunds(ctx, func(refund types.PendingRefund) bool {
+		refunds = append(refunds, refund)
+		return false
+	})
+	return refunds
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+)
+
+// SetRoute registers a route, replacing the hops of a registered route with the same name.
+func (k Keeper) SetRoute(ctx sdk.Context, route types.Route) error {
+	if err := route.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&route)
+	store.Set(types.RouteKey(route.Name), bz)
+	return nil
+}
+
+// GetRoute returns the registered route with the given name.
+func (k Keeper) GetRoute(ctx sdk.Context, name string) (types.Route, bool) {
+	var route types.Route
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RouteKey(name))
+	if bz == nil {
+		return route, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &route)
+	return route, true
+}
+
+// RemoveRoute removes the registered route with the given name.
+func (k Keeper) RemoveRoute(ctx sdk.Context, name string) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.RouteKey(name)
+	if !store.Has(key) {
+		return types.ErrRouteNotFound.Wrapf("route %s", name)
+	}
+
+	store.Delete(key)
+	return nil
+}
+
+// IterateRoutes iterates over the registered routes in order of their names.
+func (k Keeper) IterateRoutes(ctx sdk.Context, cb func(route types.Route) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RouteKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var route types.Route
+		k.cdc.MustUnmarshal(iterator.Value(), &route)
+		if cb(route) {
+			break
+		}
+	}
+}
+
+// GetAllRoutes returns the registered routes in order of their names.
+func (k Keeper) GetAllRoutes(ctx sdk.Context) []types.Route {
+	var routes []types.Route
+	k.IterateRoutes(ctx, func(route types.Route) bool {
+		routes = append(routes, route)
+		return false
+	})
+	return routes
+}
+
+// ExpandForwardRoute expands the route named in the forward metadata, if any, into its hops.
+func (k Keeper) ExpandForwardRoute(ctx sdk.Context, metadata *types.ForwardMetadata) error {
+	if metadata.Route == "" {
+		return nil
+	}
+
+	route, found := k.GetRoute(ctx, metadata.Route)
+	if !found {
+		return types.ErrRouteNotFound.Wrapf("route %s", metadata.Route)
+	}
+
+	return metadata.ExpandRoute(route)
+}
+
+// ExpandForwardUnwind expands the unwind flag of the forward metadata, if set, into the hops that return the
+// token to its origin chain. The denom is either the full denom path of the token on this chain, or its
+// ibc/{hash} denom whose trace is looked up.
+func (k Keeper) ExpandForwardUnwind(ctx sdk.Context, metadata *types.ForwardMetadata, denom string) error {
+	if !metadata.Unwind {
+		return nil
+	}
+
+	denomPath := denom
+	if strings.HasPrefix(denom, "ibc/") {
+		var err error
+		denomPath, err = k.transferKeeper.DenomPathFromHash(ctx, denom)
+		if err != nil {
+			return fmt.Errorf("failed to unwind denom %s: %w", denom, err)
+		}
+	}
+
+	return metadata.ExpandUnwind(transfertypes.ParseDenomTrace(denomPath))
+}
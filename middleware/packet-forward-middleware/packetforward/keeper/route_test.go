@@ -0,0 +1,36 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+)
+
+func TestExpandForwardUnwind(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	pfmKeeper := setup.Keepers.PacketForwardKeeper
+
+	denomTrace := transfertypes.ParseDenomTrace("transfer/channel-1/transfer/channel-5/uatom")
+	setup.Mocks.TransferKeeperMock.EXPECT().DenomPathFromHash(ctx, denomTrace.IBCDenom()).
+		Return(denomTrace.GetFullDenomPath(), nil)
+
+	// hashed denoms are unwound along their stored denom trace.
+	metadata := &types.ForwardMetadata{Receiver: "cosmos1receiver", Unwind: true}
+	require.NoError(t, pfmKeeper.ExpandForwardUnwind(ctx, metadata, denomTrace.IBCDenom()))
+	require.Equal(t, "channel-1", metadata.Channel)
+	require.NotNil(t, metadata.Next)
+
+	// forwards without unwind are left as they are.
+	metadata = &types.ForwardMetadata{Receiver: "cosmos1receiver", Port: "transfer", Channel: "channel-0"}
+	require.NoError(t, pfmKeeper.ExpandForwardUnwind(ctx, metadata, denomTrace.IBCDenom()))
+	require.Equal(t, "channel-0", metadata.Channel)
+	require.Nil(t, metadata.Next)
+}
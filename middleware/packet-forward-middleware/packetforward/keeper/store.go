@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	corestore "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// storeAccessor returns the accessor of the module store that the collections of the keeper are built with.
+// Cosmos SDK v0.47 has no KVStoreService, so the store is opened from the store key of the keeper.
+func storeAccessor(storeKey storetypes.StoreKey) func(ctx context.Context) corestore.KVStore {
+	return func(ctx context.Context) corestore.KVStore {
+		return kvStore{sdk.UnwrapSDKContext(ctx).KVStore(storeKey)}
+	}
+}
+
+// kvStore adapts a KVStore of the Cosmos SDK to the KVStore of cosmossdk.io/core, whose methods return errors.
+type kvStore struct {
+	store storetypes.KVStore
+}
+
+var _ corestore.KVStore = kvStore{}
+
+func (s kvStore) Get(key []byte) ([]byte, error) { return s.store.Get(key), nil }
+
+func (s kvStore) Has(key []byte) (bool, error) { return s.store.Has(key), nil }
+
+func (s kvStore) Set(key, value []byte) error {
+	s.store.Set(key, value)
+	return nil
+}
+
+func (s kvStore) Delete(key []byte) error {
+	s.store.Delete(key)
+	return nil
+}
+
+func (s kvStore) Iterator(start, end []byte) (corestore.Iterator, error) {
+	return s.store.Iterator(start, end), nil
+}
+
+func (s kvStore) ReverseIterator(start, end []byte) (corestore.Iterator, error) {
+	return s.store.ReverseIterator(start, end), nil
+}
+
+// protoValue is the value codec of collections of protobuf messages, encoded with the codec of the keeper.
+type protoValue[T any, PT interface {
+	*T
+	codec.ProtoMarshaler
+}] struct {
+	cdc codec.BinaryCodec
+}
+
+// newProtoValue returns the value codec of a collection of protobuf messages of type T.
+func newProtoValue[T any, PT interface {
+	*T
+	codec.ProtoMarshaler
+}](cdc codec.BinaryCodec) collcodec.ValueCodec[T] {
+	return protoValue[T, PT]{cdc: cdc}
+}
+
+func (c protoValue[T, PT]) Encode(value T) ([]byte, error) {
+	return c.cdc.Marshal(PT(&value))
+}
+
+func (c protoValue[T, PT]) Decode(b []byte) (T, error) {
+	var value T
+	err := c.cdc.Unmarshal(b, PT(&value))
+	return value, err
+}
+
+func (c protoValue[T, PT]) EncodeJSON(value T) ([]byte, error) {
+	jsonCodec, ok := c.cdc.(codec.JSONCodec)
+	if !ok {
+		return nil, fmt.Errorf("%w: codec %T cannot encode JSON", collections.ErrEncoding, c.cdc)
+	}
+	return jsonCodec.MarshalJSON(PT(&value))
+}
+
+func (c protoValue[T, PT]) DecodeJSON(b []byte) (T, error) {
+	var value T
+	jsonCodec, ok := c.cdc.(codec.JSONCodec)
+	if !ok {
+		return value, fmt.Errorf("%w: codec %T cannot decode JSON", collections.ErrEncoding, c.cdc)
+	}
+	err := jsonCodec.UnmarshalJSON(b, PT(&value))
+	return value, err
+}
+
+func (c protoValue[T, PT]) Stringify(value T) string {
+	return PT(&value).String()
+}
+
+func (c protoValue[T, PT]) ValueType() string {
+	var value T
+	return proto.MessageName(PT(&value))
+}
+
+// indexPrefix returns the store prefix of the entries of the index under the given prefix that reference refKey.
+func indexPrefix[K any](prefix []byte, refCodec collcodec.KeyCodec[K], refKey K) ([]byte, error) {
+	bz := make([]byte, len(prefix)+refCodec.SizeNonTerminal(refKey))
+	copy(bz, prefix)
+	if _, err := refCodec.EncodeNonTerminal(bz[len(prefix):], refKey); err != nil {
+		return nil, err
+	}
+	return bz, nil
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+)
+
+// SweepIntermediateAccount moves the funds left on the intermediate account of the original sender for packets
+// received on the given port and channel, e.g. after a forward failed once the funds were received. The funds are
+// transferred back to the original sender over the channel, or moved to the original sender's account on this
+// chain if toRecoverableAccount is set. The account is not swept while a refund is pending from it, since the
+// refund is sent again from the account if it fails.
+func (k *Keeper) SweepIntermediateAccount(
+	ctx sdk.Context,
+	portID, channelID string,
+	originalSender string,
+	toRecoverableAccount bool,
+) (sdk.Coins, error) {
+	intermediateAccount := types.IntermediateAccount(channelID, originalSender)
+
+	if k.hasPendingRefund(ctx, channelID, originalSender) {
+		return nil, errorsmod.Wrapf(types.ErrRefundPending, "account %s", intermediateAccount)
+	}
+
+	balances := k.bankKeeper.GetAllBalances(ctx, intermediateAccount)
+	if balances.IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrNothingToSweep, "account %s", intermediateAccount)
+	}
+
+	var recipient string
+	if toRecoverableAccount {
+		recoverableAccount, err := RecoverableAccount(originalSender)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, intermediateAccount, recoverableAccount, balances); err != nil {
+			return nil, fmt.Errorf("failed to send coins to recoverable account: %w", err)
+		}
+		recipient = recoverableAccount.String()
+	} else {
+		timeout := uint64(ctx.BlockTime().UnixNano()) + uint64(k.GetParams(ctx).RefundTimeout.Nanoseconds())
+		for _, coin := range balances {
+			// a failed transfer is refunded to the intermediate account, so it can be swept again.
+			if _, err := k.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), transfertypes.NewMsgTransfer(
+				portID,
+				channelID,
+				coin,
+				intermediateAccount.String(),
+				originalSender,
+				DefaultTransferPacketTimeoutHeight,
+				timeout,
+				"",
+			)); err != nil {
+				return nil, fmt.Errorf("failed to transfer %s back to original sender: %w", coin, err)
+			}
+		}
+		recipient = originalSender
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIntermediateAccountSwept,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyIntermediateAccount, intermediateAccount.String()),
+			sdk.NewAttribute(types.AttributeKeyRefundChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyOriginalSender, originalSender),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, balances.String()),
+		),
+	)
+
+	return balances, nil
+}
+
+// RecoverableAccount returns the account of the original sender on this chain, which holds the same address bytes
+// as the original sender on its chain.
+func RecoverableAccount(originalSender string) (sdk.AccAddress, error) {
+	_, senderBz, err := bech32.DecodeAndConvert(originalSender)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode original sender as bech32 address: %w", err)
+	}
+	return sdk.AccAddress(senderBz), nil
+}
+
+// hasPendingRefund returns true if a refund of the original sender is pending from its intermediate account for
+// packets received on the given channel.
+func (k *Keeper) hasPendingRefund(ctx sdk.Context, channelID, originalSender string) bool {
+	var found bool
+	k.IteratePendingRefunds(ctx, func(refund types.PendingRefund) bool {
+		found = refund.RefundChannelId == channelID && refund.OriginalSenderAddress == originalSender
+		return found
+	})
+	return found
+}
@@ -0,0 +1,171 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/keeper"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/test"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+)
+
+const (
+	sweepChannel = "channel-11"
+	sweepSender  = "cosmos1wnlew8ss0sqclfalvj6jkcyvnwq79fd74qxxue"
+)
+
+func TestSweepIntermediateAccount_Transfer(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	pfmKeeper := setup.Keepers.PacketForwardKeeper
+
+	intermediateAccount := types.IntermediateAccount(sweepChannel, sweepSender)
+	balances := sdk.NewCoins(sdk.NewInt64Coin("uatom", 100), sdk.NewInt64Coin("uosmo", 50))
+	timeout := uint64(ctx.BlockTime().UnixNano()) + uint64(keeper.DefaultRefundTransferPacketTimeoutTimestamp.Nanoseconds())
+
+	gomock.InOrder(
+		setup.Mocks.BankKeeperMock.EXPECT().GetAllBalances(ctx, intermediateAccount).Return(balances),
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+			sdk.WrapSDKContext(ctx),
+			transfertypes.NewMsgTransfer(
+				transfertypes.PortID, sweepChannel, balances[0], intermediateAccount.String(), sweepSender,
+				keeper.DefaultTransferPacketTimeoutHeight, timeout, "",
+			),
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 1}, nil),
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+			sdk.WrapSDKContext(ctx),
+			transfertypes.NewMsgTransfer(
+				transfertypes.PortID, sweepChannel, balances[1], intermediateAccount.String(), sweepSender,
+				keeper.DefaultTransferPacketTimeoutHeight, timeout, "",
+			),
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 2}, nil),
+	)
+
+	swept, err := pfmKeeper.SweepIntermediateAccount(ctx, transfertypes.PortID, sweepChannel, sweepSender, false)
+	require.NoError(t, err)
+	require.Equal(t, balances, swept)
+}
+
+func TestSweepIntermediateAccount_RecoverableAccount(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	pfmKeeper := setup.Keepers.PacketForwardKeeper
+
+	intermediateAccount := types.IntermediateAccount(sweepChannel, sweepSender)
+	balances := sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))
+
+	gomock.InOrder(
+		setup.Mocks.BankKeeperMock.EXPECT().GetAllBalances(ctx, intermediateAccount).Return(balances),
+		setup.Mocks.BankKeeperMock.EXPECT().SendCoins(ctx, intermediateAccount, sdk.MustAccAddressFromBech32(sweepSender), balances).Return(nil),
+	)
+
+	swept, err := pfmKeeper.SweepIntermediateAccount(ctx, transfertypes.PortID, sweepChannel, sweepSender, true)
+	require.NoError(t, err)
+	require.Equal(t, balances, swept)
+}
+
+func TestSweepIntermediateAccount_NothingToSweep(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	pfmKeeper := setup.Keepers.PacketForwardKeeper
+
+	intermediateAccount := types.IntermediateAccount(sweepChannel, sweepSender)
+	setup.Mocks.BankKeeperMock.EXPECT().GetAllBalances(ctx, intermediateAccount).Return(sdk.NewCoins())
+
+	_, err := pfmKeeper.SweepIntermediateAccount(ctx, transfertypes.PortID, sweepChannel, sweepSender, false)
+	require.ErrorIs(t, err, types.ErrNothingToSweep)
+}
+
+func TestSweepIntermediateAccount_RefundPending(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	pfmKeeper := setup.Keepers.PacketForwardKeeper
+
+	// the refund is sent again from the intermediate account if it fails, so the account is not swept meanwhile.
+	genesis := types.DefaultGenesisState()
+	genesis.PendingRefunds = []types.PendingRefund{{
+		OriginalSenderAddress: sweepSender,
+		RefundPortId:          transfertypes.PortID,
+		RefundChannelId:       sweepChannel,
+		RefundSequence:        1,
+		Token:                 sdk.NewInt64Coin("uatom", 100),
+		PortId:                transfertypes.PortID,
+		ChannelId:             sweepChannel,
+		Sequence:              1,
+	}}
+	pfmKeeper.InitGenesis(ctx, *genesis)
+
+	_, err := pfmKeeper.SweepIntermediateAccount(ctx, transfertypes.PortID, sweepChannel, sweepSender, true)
+	require.ErrorIs(t, err, types.ErrRefundPending)
+}
+
+func TestMsgSweepIntermediateAccount_Signer(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	pfmKeeper := setup.Keepers.PacketForwardKeeper
+	msgServer := keeper.NewMsgServerImpl(pfmKeeper)
+
+	intermediateAccount := types.IntermediateAccount(sweepChannel, sweepSender)
+	balances := sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))
+
+	msg := func(signer string) *types.MsgSweepIntermediateAccount {
+		return &types.MsgSweepIntermediateAccount{
+			Signer:               signer,
+			PortId:               transfertypes.PortID,
+			ChannelId:            sweepChannel,
+			OriginalSender:       sweepSender,
+			ToRecoverableAccount: true,
+		}
+	}
+
+	// other accounts cannot move the funds of the original sender.
+	_, err := msgServer.SweepIntermediateAccount(sdk.WrapSDKContext(ctx), msg(test.AccAddress().String()))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	for _, signer := range []string{sweepSender, pfmKeeper.GetAuthority()} {
+		gomock.InOrder(
+			setup.Mocks.BankKeeperMock.EXPECT().GetAllBalances(ctx, intermediateAccount).Return(balances),
+			setup.Mocks.BankKeeperMock.EXPECT().SendCoins(ctx, intermediateAccount, sdk.MustAccAddressFromBech32(sweepSender), balances).Return(nil),
+		)
+
+		res, err := msgServer.SweepIntermediateAccount(sdk.WrapSDKContext(ctx), msg(signer))
+		require.NoError(t, err)
+		require.Equal(t, balances, res.Swept)
+	}
+}
+
+func TestQueryIntermediateAccount(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	pfmKeeper := setup.Keepers.PacketForwardKeeper
+
+	intermediateAccount := types.IntermediateAccount(sweepChannel, sweepSender)
+	balances := sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))
+	setup.Mocks.BankKeeperMock.EXPECT().GetAllBalances(ctx, intermediateAccount).Return(balances)
+
+	res, err := pfmKeeper.IntermediateAccount(sdk.WrapSDKContext(ctx), &types.QueryIntermediateAccountRequest{
+		ChannelId:      sweepChannel,
+		OriginalSender: sweepSender,
+	})
+	require.NoError(t, err)
+	require.Equal(t, intermediateAccount.String(), res.Address)
+	require.Equal(t, balances, res.Balances)
+}
@@ -0,0 +1,66 @@
+package genesisv2
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// inFlightPacketsKey is the JSON key of the in-flight packets in both genesis formats.
+const inFlightPacketsKey = "in_flight_packets"
+
+// MigrateJSON migrates an exported x/packetforward genesis state from the v1 format to the v2 format.
+// Specifically, it converts the in-flight packets from a map keyed by the refund packet key of their
+// forwarded packet to a list of entries with explicit port, channel and sequence, sorted by forwarded
+// packet. A genesis state that already is in the v2 format is returned unchanged.
+func MigrateJSON(cdc codec.JSONCodec, bz json.RawMessage) (json.RawMessage, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+
+	legacyInFlightPackets, ok := fields[inFlightPacketsKey]
+	if !ok || !bytes.HasPrefix(bytes.TrimSpace(legacyInFlightPackets), []byte("{")) {
+		return bz, nil
+	}
+
+	var legacy map[string]json.RawMessage
+	if err := json.Unmarshal(legacyInFlightPackets, &legacy); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal legacy in-flight packets: %w", err)
+	}
+
+	entries := make([]types.InFlightPacketEntry, 0, len(legacy))
+	for key, value := range legacy {
+		channelID, portID, sequence, err := types.ParseRefundPacketKey([]byte(key))
+		if err != nil {
+			return nil, err
+		}
+
+		var inFlightPacket types.InFlightPacket
+		if err := cdc.UnmarshalJSON(value, &inFlightPacket); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal in-flight packet %s: %w", key, err)
+		}
+
+		entries = append(entries, types.NewInFlightPacketEntry(portID, channelID, sequence, inFlightPacket))
+	}
+	types.SortInFlightPacketEntries(entries)
+
+	// the remaining fields are unchanged between the formats.
+	delete(fields, inFlightPacketsKey)
+	remaining, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	var gs types.GenesisState
+	if err := cdc.UnmarshalJSON(remaining, &gs); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+	gs.InFlightPackets = entries
+
+	return cdc.MarshalJSON(&gs)
+}
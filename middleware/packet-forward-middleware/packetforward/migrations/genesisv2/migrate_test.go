@@ -0,0 +1,66 @@
+package genesisv2_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/migrations/genesisv2"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	"github.com/stretchr/testify/require"
+
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+// TestMigrateJSON validates the in-flight packets of a v1 genesis state are converted to sorted entries and the
+// other fields are kept.
+func TestMigrateJSON(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(packetforward.AppModuleBasic{}).Codec
+
+	// the v1 format keyed the in-flight packets by the refund packet key of their forwarded packet.
+	legacy := []byte(`{
+		"params": {"fee_percentage": "0.020000000000000000", "memo_byte_gas": "5"},
+		"in_flight_packets": {
+			"channel-1/transfer/10": {"original_sender_address": "cosmos1sender", "refund_channel_id": "channel-0", "retries_remaining": 2},
+			"channel-1/transfer/2": {"original_sender_address": "cosmos1sender", "refund_channel_id": "channel-0"},
+			"channel-0/transfer/7": {"original_sender_address": "cosmos1other", "refund_channel_id": "channel-2"}
+		},
+		"routes": [{"name": "hub", "hops": [{"port_id": "transfer", "channel_id": "channel-3"}]}]
+	}`)
+
+	bz, err := genesisv2.MigrateJSON(cdc, legacy)
+	require.NoError(t, err)
+
+	var gs types.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(bz, &gs))
+	require.Len(t, gs.InFlightPackets, 3)
+	for i, exp := range []struct {
+		channelID       string
+		sequence        uint64
+		originalSender  string
+		refundChannelID string
+		retries         int32
+	}{
+		{"channel-0", 7, "cosmos1other", "channel-2", 0},
+		{"channel-1", 2, "cosmos1sender", "channel-0", 0},
+		{"channel-1", 10, "cosmos1sender", "channel-0", 2},
+	} {
+		entry := gs.InFlightPackets[i]
+		require.Equal(t, "transfer", entry.PortId)
+		require.Equal(t, exp.channelID, entry.ChannelId)
+		require.Equal(t, exp.sequence, entry.Sequence)
+		require.Equal(t, exp.originalSender, entry.InFlightPacket.OriginalSenderAddress)
+		require.Equal(t, exp.refundChannelID, entry.InFlightPacket.RefundChannelId)
+		require.Equal(t, exp.retries, entry.InFlightPacket.RetriesRemaining)
+	}
+	require.Equal(t, uint64(5), gs.Params.MemoByteGas)
+	require.Len(t, gs.Routes, 1)
+
+	// a v2 genesis state is left unchanged.
+	migrated, err := genesisv2.MigrateJSON(cdc, bz)
+	require.NoError(t, err)
+	require.Equal(t, bz, migrated)
+
+	// keys that are not refund packet keys cannot be migrated.
+	_, err = genesisv2.MigrateJSON(cdc, []byte(`{"in_flight_packets": {"invalid": {}}}`))
+	require.Error(t, err)
+}
@@ -23,7 +23,8 @@ func Migrate(
 	var currParams types.Params
 	legacySubspace.GetParamSet(ctx, &currParams)
 
-	if err := currParams.Validate(); err != nil {
+	// the retries and timeouts of forwards are set in the params by the migration to consensus version 4.
+	if err := currParams.WithDefaultForwardLimits().Validate(); err != nil {
 		return err
 	}
 
@@ -45,7 +46,7 @@ func validate(store sdk.KVStore, cdc codec.BinaryCodec, currParams types.Params)
 	}
 
 	if !currParams.FeePercentage.Equal(res.FeePercentage) {
-		return fmt.Errorf("expected %s but got %s", currParams, res)
+		return fmt.Errorf("expected %s but got %s", &currParams, &res)
 	}
 
 	return nil
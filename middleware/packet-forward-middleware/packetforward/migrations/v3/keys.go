@@ -0,0 +1,46 @@
+package v3
+
+import (
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The store keys of the in-flight packets and their indexes in consensus versions 3 and 4. The migration to
+// consensus version 5 moves them into the in-flight packet collection.
+
+// InFlightPacketKey returns the store key of the in-flight packet of a forwarded packet.
+func InFlightPacketKey(channelID, portID string, sequence uint64) []byte {
+	return concat(types.InFlightPacketKeyPrefix, types.RefundPacketKey(channelID, portID, sequence))
+}
+
+// InFlightPacketBySenderKey returns the index key of an in-flight packet by its original sender.
+func InFlightPacketBySenderKey(originalSender, channelID, portID string, sequence uint64) []byte {
+	return concat(
+		types.InFlightPacketBySenderKeyPrefix,
+		lengthPrefix([]byte(originalSender)),
+		types.RefundPacketKey(channelID, portID, sequence),
+	)
+}
+
+// InFlightPacketByRefundChannelKey returns the index key of an in-flight packet by its refund channel.
+func InFlightPacketByRefundChannelKey(refundPortID, refundChannelID, channelID, portID string, sequence uint64) []byte {
+	return concat(
+		types.InFlightPacketByRefundChannelKeyPrefix,
+		lengthPrefix([]byte(refundPortID)),
+		lengthPrefix([]byte(refundChannelID)),
+		types.RefundPacketKey(channelID, portID, sequence),
+	)
+}
+
+func lengthPrefix(bz []byte) []byte {
+	return concat(sdk.Uint64ToBigEndian(uint64(len(bz))), bz)
+}
+
+func concat(parts ...[]byte) []byte {
+	var key []byte
+	for _, part := range parts {
+		key = append(key, part...)
+	}
+	return key
+}
@@ -0,0 +1,76 @@
+package v3
+
+import (
+	"fmt"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// legacyInFlightPacketKeyMin is the lowest leading byte of an in-flight packet key in consensus version 2.
+// In-flight packet keys were unprefixed and started with a printable channel identifier, while all other
+// module state is stored under single byte prefixes below this value.
+const legacyInFlightPacketKeyMin = 0x20
+
+// Migrate migrates the x/packetforward module state from the consensus version 2 to
+// version 3. Specifically, it moves the in-flight packets from their unprefixed keys
+// under the in-flight packet prefix and indexes them by original sender and by refund channel,
+// and sets the gas consumed per memo byte, which params of version 2 do not hold, to its default.
+func Migrate(
+	_ sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+) error {
+	if err := migrateParams(store, cdc); err != nil {
+		return err
+	}
+
+	itr := store.Iterator([]byte{legacyInFlightPacketKeyMin}, nil)
+
+	// collect the legacy keys first, the store must not be written to while iterating.
+	var keys, values [][]byte
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, itr.Key())
+		values = append(values, itr.Value())
+	}
+	itr.Close()
+
+	for i, key := range keys {
+		channelID, portID, sequence, err := types.ParseRefundPacketKey(key)
+		if err != nil {
+			return err
+		}
+
+		var inFlightPacket types.InFlightPacket
+		if err := cdc.Unmarshal(values[i], &inFlightPacket); err != nil {
+			return err
+		}
+
+		store.Delete(key)
+		store.Set(InFlightPacketKey(channelID, portID, sequence), values[i])
+		store.Set(InFlightPacketBySenderKey(inFlightPacket.OriginalSenderAddress, channelID, portID, sequence), []byte{})
+		store.Set(InFlightPacketByRefundChannelKey(
+			inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId, channelID, portID, sequence,
+		), []byte{})
+	}
+
+	return nil
+}
+
+func migrateParams(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return fmt.Errorf("expected params at key %s but not found", types.ParamsKey)
+	}
+
+	var params types.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	params = params.WithMemoByteGas(types.DefaultMemoByteGas)
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+	return nil
+}
@@ -0,0 +1,54 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward"
+	v3 "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/migrations/v3"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+// TestMigrate validates the in-flight packets move under the in-flight packet prefix and are indexed, and the
+// gas consumed per memo byte is set to its default.
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(packetforward.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// params of consensus version 2 do not hold the gas consumed per memo byte.
+	params := types.Params{
+		FeePercentage: sdk.NewDecWithPrec(2, 2),
+		BlockedDenoms: []string{"ujuno"},
+	}
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	inFlightPacket := types.InFlightPacket{
+		OriginalSenderAddress: "cosmos1wnlew8ss0sqclfalvj6jkcyvnwq79fd74qxxue",
+		RefundChannelId:       "channel-11",
+		RefundPortId:          "transfer",
+		RefundSequence:        3,
+	}
+	bz := cdc.MustMarshal(&inFlightPacket)
+	legacyKey := types.RefundPacketKey("channel-0", "transfer", 7)
+	store.Set(legacyKey, bz)
+
+	require.NoError(t, v3.Migrate(ctx, store, cdc))
+
+	require.False(t, store.Has(legacyKey))
+	require.Equal(t, bz, store.Get(v3.InFlightPacketKey("channel-0", "transfer", 7)))
+	require.True(t, store.Has(v3.InFlightPacketBySenderKey(inFlightPacket.OriginalSenderAddress, "channel-0", "transfer", 7)))
+	require.True(t, store.Has(v3.InFlightPacketByRefundChannelKey("transfer", "channel-11", "channel-0", "transfer", 7)))
+
+	var res types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(types.ParamsKey), &res))
+	require.Equal(t, params.WithMemoByteGas(types.DefaultMemoByteGas), res)
+}
@@ -0,0 +1,65 @@
+package v4
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrate migrates the x/packetforward module state from the consensus version 3 to
+// version 4. Specifically, it moves the retries and timeouts of forwards, which the chain
+// passed to the middleware constructor, into the module parameters. The bounds of the retries and timeout set in a memo take their
+// defaults, widened to include the constructor values. Non-positive timeouts, which the
+// constructor did not reject, are replaced by their defaults.
+func Migrate(
+	_ sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	retriesOnTimeout uint8,
+	forwardTimeout time.Duration,
+	refundTimeout time.Duration,
+) error {
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return fmt.Errorf("expected params at key %s but not found", types.ParamsKey)
+	}
+
+	var params types.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	if forwardTimeout <= 0 {
+		forwardTimeout = types.DefaultForwardTimeout
+	}
+	if refundTimeout <= 0 {
+		refundTimeout = types.DefaultRefundTimeout
+	}
+
+	maxRetries := types.DefaultMaxRetries
+	if uint32(retriesOnTimeout) > maxRetries {
+		maxRetries = uint32(retriesOnTimeout)
+	}
+	minForwardTimeout, maxForwardTimeout := types.DefaultMinForwardTimeout, types.DefaultMaxForwardTimeout
+	if forwardTimeout < minForwardTimeout {
+		minForwardTimeout = forwardTimeout
+	}
+	if forwardTimeout > maxForwardTimeout {
+		maxForwardTimeout = forwardTimeout
+	}
+
+	params = params.
+		WithForwardRetries(uint32(retriesOnTimeout), maxRetries).
+		WithForwardTimeouts(forwardTimeout, minForwardTimeout, maxForwardTimeout).
+		WithRefundTimeout(refundTimeout)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+	return nil
+}
@@ -0,0 +1,77 @@
+package v4_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward"
+	v4 "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/migrations/v4"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+// TestMigrate validates the retries and timeouts of forwards are set to the values the middleware was
+// constructed with and the other params are kept.
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(packetforward.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	// params of consensus version 3 do not hold the retries and timeouts of forwards.
+	params := types.Params{
+		FeePercentage: sdk.NewDecWithPrec(2, 2),
+		MemoByteGas:   5,
+		MaxForwardGas: 500_000,
+		BlockedDenoms: []string{"ujuno"},
+	}
+	expected := params
+
+	for _, tc := range []struct {
+		name             string
+		retriesOnTimeout uint8
+		forwardTimeout   time.Duration
+		refundTimeout    time.Duration
+		expected         types.Params
+	}{
+		{
+			"defaults", 0, types.DefaultForwardTimeout, types.DefaultRefundTimeout,
+			expected.WithDefaultForwardLimits(),
+		},
+		{
+			"constructor values", 3, 5 * time.Minute, time.Hour,
+			expected.WithDefaultForwardLimits().
+				WithForwardRetries(3, types.DefaultMaxRetries).
+				WithForwardTimeouts(5*time.Minute, types.DefaultMinForwardTimeout, types.DefaultMaxForwardTimeout).
+				WithRefundTimeout(time.Hour),
+		},
+		{
+			"constructor values outside the default bounds", 20, 30 * time.Second, time.Hour,
+			expected.
+				WithForwardRetries(20, 20).
+				WithForwardTimeouts(30*time.Second, 30*time.Second, types.DefaultMaxForwardTimeout).
+				WithRefundTimeout(time.Hour),
+		},
+		{
+			"zero timeouts", 0, 0, 0,
+			expected.WithDefaultForwardLimits(),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			storeKey := sdk.NewKVStoreKey(types.ModuleName)
+			tKey := sdk.NewTransientStoreKey("transient_test")
+			ctx := testutil.DefaultContext(storeKey, tKey)
+			store := ctx.KVStore(storeKey)
+			store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+			require.NoError(t, v4.Migrate(ctx, store, cdc, tc.retriesOnTimeout, tc.forwardTimeout, tc.refundTimeout))
+
+			var res types.Params
+			require.NoError(t, cdc.Unmarshal(store.Get(types.ParamsKey), &res))
+			require.Equal(t, tc.expected, res)
+			require.NoError(t, res.ValidateForwardLimits())
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package v5
+
+import (
+	"context"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InFlightPackets is the in-flight packet collection of consensus version 5, which indexes the in-flight packets
+// it stores.
+type InFlightPackets interface {
+	Set(ctx context.Context, key types.InFlightPacketKey, inFlightPacket types.InFlightPacket) error
+}
+
+// Migrate migrates the x/packetforward module state from the consensus version 4 to
+// version 5. Specifically, it moves the in-flight packets and their indexes into the
+// in-flight packet collection, which keeps them under the same prefixes. The params
+// are stored in the same encoding by their collection and are not migrated.
+func Migrate(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	inFlightPackets InFlightPackets,
+) error {
+	inFlightPacketStore := prefix.NewStore(store, types.InFlightPacketKeyPrefix)
+	itr := inFlightPacketStore.Iterator(nil, nil)
+
+	// collect the legacy entries first, the store must not be written to while iterating.
+	var keys, values [][]byte
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, itr.Key())
+		values = append(values, itr.Value())
+	}
+	itr.Close()
+
+	entries := make([]types.InFlightPacketEntry, len(keys))
+	for i, key := range keys {
+		channelID, portID, sequence, err := types.ParseRefundPacketKey(key)
+		if err != nil {
+			return err
+		}
+
+		var inFlightPacket types.InFlightPacket
+		if err := cdc.Unmarshal(values[i], &inFlightPacket); err != nil {
+			return err
+		}
+		entries[i] = types.NewInFlightPacketEntry(portID, channelID, sequence, inFlightPacket)
+	}
+
+	for _, keyPrefix := range [][]byte{
+		types.InFlightPacketKeyPrefix,
+		types.InFlightPacketBySenderKeyPrefix,
+		types.InFlightPacketByRefundChannelKeyPrefix,
+	} {
+		deletePrefix(store, keyPrefix)
+	}
+
+	for _, entry := range entries {
+		key := types.NewInFlightPacketKey(entry.ChannelId, entry.PortId, entry.Sequence)
+		if err := inFlightPackets.Set(ctx, key, entry.InFlightPacket); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deletePrefix deletes every entry of the store under the given prefix.
+func deletePrefix(store sdk.KVStore, keyPrefix []byte) {
+	prefixStore := prefix.NewStore(store, keyPrefix)
+	itr := prefixStore.Iterator(nil, nil)
+
+	var keys [][]byte
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, itr.Key())
+	}
+	itr.Close()
+
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
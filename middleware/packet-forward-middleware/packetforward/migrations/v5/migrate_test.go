@@ -0,0 +1,74 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/keeper"
+	v3 "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/migrations/v3"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+// TestMigrate validates the in-flight packets and their indexes move into the in-flight packet collection and the
+// params are read from their collection unchanged.
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(packetforward.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	params := types.DefaultParams()
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	inFlightPacket := types.InFlightPacket{
+		OriginalSenderAddress: "cosmos1wnlew8ss0sqclfalvj6jkcyvnwq79fd74qxxue",
+		RefundChannelId:       "channel-11",
+		RefundPortId:          "transfer",
+		RefundSequence:        3,
+	}
+	legacyKeys := [][]byte{
+		v3.InFlightPacketKey("channel-0", "transfer", 7),
+		v3.InFlightPacketBySenderKey(inFlightPacket.OriginalSenderAddress, "channel-0", "transfer", 7),
+		v3.InFlightPacketByRefundChannelKey("transfer", "channel-11", "channel-0", "transfer", 7),
+	}
+	store.Set(legacyKeys[0], cdc.MustMarshal(&inFlightPacket))
+	store.Set(legacyKeys[1], []byte{})
+	store.Set(legacyKeys[2], []byte{})
+
+	k := keeper.NewKeeper(cdc, storeKey, nil, nil, nil, nil, nil, "authority")
+	require.NoError(t, keeper.NewMigrator(k, nil).Migrate4to5(ctx))
+
+	for _, key := range legacyKeys {
+		require.False(t, store.Has(key))
+	}
+	require.Equal(t, params, k.GetParams(ctx))
+
+	var entries []types.InFlightPacketEntry
+	k.IterateInFlightPackets(ctx, func(key types.InFlightPacketKey, inFlightPacket types.InFlightPacket) bool {
+		entries = append(entries, types.NewInFlightPacketEntry(key.K1().K2(), key.K1().K1(), key.K2(), inFlightPacket))
+		return false
+	})
+	expected := types.NewInFlightPacketEntry("transfer", "channel-0", 7, inFlightPacket)
+	require.Equal(t, []types.InFlightPacketEntry{expected}, entries)
+
+	bySender, err := k.InFlightPacketsBySender(ctx, &types.QueryInFlightPacketsBySenderRequest{
+		OriginalSender: inFlightPacket.OriginalSenderAddress,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.InFlightPacketEntry{expected}, bySender.InFlightPackets)
+
+	byRefundChannel, err := k.InFlightPacketsByRefundChannel(ctx, &types.QueryInFlightPacketsByRefundChannelRequest{
+		PortId:    "transfer",
+		ChannelId: "channel-11",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.InFlightPacketEntry{expected}, byRefundChannel.InFlightPackets)
+}
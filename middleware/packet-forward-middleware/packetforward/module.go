@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/client/cli"
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/exported"
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/keeper"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/simulation"
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -27,6 +28,7 @@ var (
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
+	_ module.HasInvariants       = AppModule{}
 )
 
 // AppModuleBasic is the packetforward AppModuleBasic
@@ -60,7 +62,11 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingCo
 		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
 	}
 
-	return gs.Validate()
+	// every invalid in-flight packet is reported on its own line.
+	if err := gs.Validate(); err != nil {
+		return fmt.Errorf("invalid %s genesis state:\n%w", types.ModuleName, err)
+	}
+	return nil
 }
 
 // RegisterRESTRoutes implements AppModuleBasic interface
@@ -88,6 +94,12 @@ type AppModule struct {
 
 	// legacySubspace is used solely for migration of x/params managed parameters
 	legacySubspace exported.Subspace
+
+	// the keepers below are only used by the simulation operations
+	accountKeeper  simulation.AccountKeeper
+	bankKeeper     simulation.BankKeeper
+	channelKeeper  simulation.ChannelKeeper
+	transferKeeper simulation.TransferKeeper
 }
 
 // NewAppModule creates a new packetforward module
@@ -98,11 +110,34 @@ func NewAppModule(k *keeper.Keeper, ss exported.Subspace) AppModule {
 	}
 }
 
+// NewAppModuleWithSimulation creates a new packetforward module that also has the keepers its simulation
+// operations need to sign transactions and to open and relay over loopback channels.
+func NewAppModuleWithSimulation(
+	k *keeper.Keeper,
+	ss exported.Subspace,
+	ak simulation.AccountKeeper,
+	bk simulation.BankKeeper,
+	ck simulation.ChannelKeeper,
+	tk simulation.TransferKeeper,
+) AppModule {
+	am := NewAppModule(k, ss)
+	am.accountKeeper = ak
+	am.bankKeeper = bk
+	am.channelKeeper = ck
+	am.transferKeeper = tk
+	return am
+}
+
 // QuerierRoute implements the AppModule interface
 func (AppModule) QuerierRoute() string {
 	return types.QuerierRoute
 }
 
+// RegisterInvariants registers the packetforward module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
+
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
@@ -112,6 +147,15 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 3 to 4: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 4 to 5: %v", types.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the packetforward module. It returns
@@ -137,10 +181,12 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 5 }
 
-// BeginBlock implements the AppModule interface
-func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+// BeginBlock implements the AppModule interface. It prunes the expired forward receipts.
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	am.keeper.PruneReceipts(ctx)
+}
 
 // EndBlock implements the AppModule interface
 func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
@@ -150,7 +196,9 @@ func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.Valid
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the packetforward module.
-func (AppModule) GenerateGenesisState(_ *module.SimulationState) {}
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
 
 // ProposalContents doesn't return any content functions for governance proposals.
 func (AppModule) ProposalContents(_ module.SimulationState) []simtypes.WeightedProposalContent { //nolint:staticcheck // WeightedProposalContent is necessary to satisfy the module interface
@@ -158,9 +206,19 @@ func (AppModule) ProposalContents(_ module.SimulationState) []simtypes.WeightedP
 }
 
 // RegisterStoreDecoder registers a decoder for packetforward module's types
-func (am AppModule) RegisterStoreDecoder(_ sdk.StoreDecoderRegistry) {}
+func (am AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simulation.NewDecodeStore(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()))
+}
 
 // WeightedOperations returns the all the packetforward module operations with their respective weights.
-func (am AppModule) WeightedOperations(_ module.SimulationState) []simtypes.WeightedOperation {
-	return nil
+// There are none unless the module was created with NewAppModuleWithSimulation.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	if am.accountKeeper == nil {
+		return nil
+	}
+
+	return simulation.WeightedOperations(
+		simState.AppParams, simState.Cdc, am.keeper,
+		am.accountKeeper, am.bankKeeper, am.channelKeeper, am.transferKeeper,
+	)
 }
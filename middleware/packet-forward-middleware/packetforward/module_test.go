@@ -1,10 +1,13 @@
 package packetforward_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward"
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/keeper"
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/test"
@@ -13,9 +16,15 @@ import (
 	"go.uber.org/mock/gomock"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	feetypes "github.com/cosmos/ibc-go/v7/modules/apps/29-fee/types"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
 
 var (
@@ -649,3 +658,970 @@ func TestOnRecvPacket_ForwardMultihopJSONNext(t *testing.T) {
 	err = forwardMiddleware.OnAcknowledgementPacket(ctx, packet2, successAck, senderAccAddr)
 	require.NoError(t, err)
 }
+
+func TestOnRecvPacket_ForwardingPaused(t *testing.T) {
+	var err error
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	cdc := setup.Initializer.Marshaler
+	forwardMiddleware := setup.ForwardMiddleware
+	pfmKeeper := setup.Keepers.PacketForwardKeeper
+
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	senderAccAddr := test.AccAddress()
+	testCoin := sdk.NewCoin(denom, sdk.NewInt(100))
+	metadata := &types.PacketMetadata{Forward: &types.ForwardMetadata{
+		Receiver: destAddr,
+		Port:     port,
+		Channel:  channel,
+	}}
+	packetOrig := transferPacket(t, senderAddr, hostAddr, metadata)
+	packetModifiedSender := transferPacket(t, senderAddr, intermediateAddr, nil)
+	packetFwd := transferPacket(t, intermediateAddr, destAddr, nil)
+
+	acknowledgement := channeltypes.NewResultAcknowledgement([]byte("test"))
+	successAck := cdc.MustMarshalJSON(&acknowledgement)
+
+	// Expected mocks
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetModifiedSender, senderAccAddr).
+			Return(acknowledgement),
+
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+			sdk.WrapSDKContext(ctx),
+			transfertypes.NewMsgTransfer(
+				port,
+				channel,
+				testCoin,
+				intermediateAddr,
+				destAddr,
+				keeper.DefaultTransferPacketTimeoutHeight,
+				uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
+				"",
+			),
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+
+		setup.Mocks.IBCModuleMock.EXPECT().OnAcknowledgementPacket(ctx, packetFwd, successAck, senderAccAddr).
+			Return(nil),
+	)
+
+	// chain B with packetforward module receives packet and forwards. ack should be nil so that it is not written yet.
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.Nil(t, ack)
+
+	for _, tc := range []struct {
+		name       string
+		channelIDs []string
+		denoms     []string
+	}{
+		{"global", nil, nil},
+		{"source channel", []string{testDestinationChannel}, nil},
+		{"forward channel", []string{channel}, nil},
+		{"denom", nil, []string{denom}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, pfmKeeper.SetForwardingPaused(ctx, true, tc.channelIDs, tc.denoms))
+
+			// new forwards are rejected without receiving funds so that they are refunded on chain A.
+			ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+			require.False(t, ack.Success())
+
+			expectedAck := &channeltypes.Acknowledgement{}
+			require.NoError(t, cdc.UnmarshalJSON(ack.Acknowledgement(), expectedAck))
+			require.Contains(t, expectedAck.GetError(), types.ErrForwardingPaused.Error())
+
+			require.NoError(t, pfmKeeper.SetForwardingPaused(ctx, false, tc.channelIDs, tc.denoms))
+		})
+	}
+
+	require.NoError(t, pfmKeeper.SetForwardingPaused(ctx, true, nil, nil))
+
+	// the in-flight packet still settles while forwarding is paused.
+	err = forwardMiddleware.OnAcknowledgementPacket(ctx, packetFwd, successAck, senderAccAddr)
+	require.NoError(t, err)
+}
+
+func TestOnRecvPacket_ForwardTokenRejected(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	cdc := setup.Initializer.Marshaler
+	forwardMiddleware := setup.ForwardMiddleware
+	pfmKeeper := setup.Keepers.PacketForwardKeeper
+
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	senderAccAddr := test.AccAddress()
+	metadata := &types.PacketMetadata{Forward: &types.ForwardMetadata{
+		Receiver: destAddr,
+		Port:     port,
+		Channel:  channel,
+	}}
+	packetOrig := transferPacket(t, senderAddr, hostAddr, metadata)
+
+	for _, tc := range []struct {
+		name              string
+		allowedDenoms     []string
+		blockedDenoms     []string
+		minForwardAmounts sdk.Coins
+		expErr            error
+	}{
+		{"not allowed", []string{"uosmo"}, nil, nil, types.ErrDenomNotForwardable},
+		{"blocked", nil, []string{denom}, nil, types.ErrDenomNotForwardable},
+		{"below minimum", nil, nil, sdk.NewCoins(sdk.NewInt64Coin(denom, 101)), types.ErrForwardAmountTooLow},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams().
+				WithAllowedDenoms(tc.allowedDenoms...).
+				WithBlockedDenoms(tc.blockedDenoms...).
+				WithMinForwardAmounts(tc.minForwardAmounts)
+			require.NoError(t, pfmKeeper.SetParams(ctx, params))
+
+			// the forward is rejected without receiving funds so that it is refunded on chain A.
+			ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+			require.False(t, ack.Success())
+
+			expectedAck := &channeltypes.Acknowledgement{}
+			require.NoError(t, cdc.UnmarshalJSON(ack.Acknowledgement(), expectedAck))
+			require.Contains(t, expectedAck.GetError(), tc.expErr.Error())
+		})
+	}
+}
+
+func TestNewIBCMiddleware_LegacyForwardLimits(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	pfmKeeper := setup.Keepers.PacketForwardKeeper
+
+	// the deprecated constructor records its arguments for the migration to consensus version 4.
+	packetforward.NewIBCMiddleware(setup.Mocks.IBCModuleMock, pfmKeeper, 3, 5*time.Minute, time.Hour)
+
+	// params of consensus version 3 do not hold the retries and timeouts of forwards.
+	// they are not valid params anymore, so they are written to the store directly.
+	legacyParams := types.Params{FeePercentage: types.DefaultFeePercentage, MemoByteGas: types.DefaultMemoByteGas}
+	ctx.KVStore(setup.Keepers.PacketForwardStoreKey).Set(types.ParamsKey, setup.Initializer.Marshaler.MustMarshal(&legacyParams))
+	require.NoError(t, keeper.NewMigrator(pfmKeeper, nil).Migrate3to4(ctx))
+
+	expected := types.DefaultParams().
+		WithForwardRetries(3, types.DefaultMaxRetries).
+		WithForwardTimeouts(5*time.Minute, types.DefaultMinForwardTimeout, types.DefaultMaxForwardTimeout).
+		WithRefundTimeout(time.Hour)
+	require.Equal(t, expected, pfmKeeper.GetParams(ctx))
+}
+
+func TestOnRecvPacket_ForwardInvalidAmount(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	cdc := setup.Initializer.Marshaler
+	forwardMiddleware := setup.ForwardMiddleware
+
+	senderAccAddr := test.AccAddress()
+	memo, err := json.Marshal(&types.PacketMetadata{Forward: &types.ForwardMetadata{
+		Receiver: destAddr,
+		Port:     port,
+		Channel:  channel,
+	}})
+	require.NoError(t, err)
+
+	for _, amount := range []string{"-5", "0", "abc"} {
+		t.Run(amount, func(t *testing.T) {
+			packet := transferPacket(t, senderAddr, hostAddr, nil)
+			packet.Data = transfertypes.ModuleCdc.MustMarshalJSON(&transfertypes.FungibleTokenPacketData{
+				Denom:    testDenom,
+				Amount:   amount,
+				Sender:   senderAddr,
+				Receiver: hostAddr,
+				Memo:     string(memo),
+			})
+
+			// the forward is rejected without receiving funds instead of panicking.
+			var ack ibcexported.Acknowledgement
+			require.NotPanics(t, func() {
+				ack = forwardMiddleware.OnRecvPacket(ctx, packet, senderAccAddr)
+			})
+			require.False(t, ack.Success())
+
+			expectedAck := &channeltypes.Acknowledgement{}
+			require.NoError(t, cdc.UnmarshalJSON(ack.Acknowledgement(), expectedAck))
+			require.Contains(t, expectedAck.GetError(), "error parsing amount for forward")
+		})
+	}
+}
+
+func TestOnRecvPacket_ForwardRoute(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	cdc := setup.Initializer.Marshaler
+	forwardMiddleware := setup.ForwardMiddleware
+	pfmKeeper := setup.Keepers.PacketForwardKeeper
+
+	require.NoError(t, pfmKeeper.SetRoute(ctx, types.NewRoute("dest-via-host2",
+		types.NewRouteHop(port, channel),
+		types.NewRouteHop(port, channel2),
+	)))
+
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	senderAccAddr := test.AccAddress()
+	testCoin := sdk.NewCoin(denom, sdk.NewInt(100))
+	metadata := &types.PacketMetadata{Forward: &types.ForwardMetadata{
+		Receiver: destAddr,
+		Route:    "dest-via-host2",
+	}}
+	packetOrig := transferPacket(t, senderAddr, hostAddr, metadata)
+	packetModifiedSender := transferPacket(t, senderAddr, intermediateAddr, nil)
+
+	// the second hop of the route is passed on in the memo of the first forward.
+	nextMemo, err := json.Marshal(&types.PacketMetadata{Forward: &types.ForwardMetadata{
+		Receiver: destAddr,
+		Port:     port,
+		Channel:  channel2,
+	}})
+	require.NoError(t, err)
+
+	acknowledgement := channeltypes.NewResultAcknowledgement([]byte("test"))
+
+	// Expected mocks
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetModifiedSender, senderAccAddr).
+			Return(acknowledgement),
+
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+			sdk.WrapSDKContext(ctx),
+			transfertypes.NewMsgTransfer(
+				port,
+				channel,
+				testCoin,
+				intermediateAddr,
+				types.IntermediateReceiver,
+				keeper.DefaultTransferPacketTimeoutHeight,
+				uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
+				string(nextMemo),
+			),
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+	)
+
+	// chain B with packetforward module receives packet and forwards. ack should be nil so that it is not written yet.
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.Nil(t, ack)
+
+	// forwards over unknown routes are rejected without receiving funds.
+	metadata.Forward.Route = "unknown"
+	ack = forwardMiddleware.OnRecvPacket(ctx, transferPacket(t, senderAddr, hostAddr, metadata), senderAccAddr)
+	require.False(t, ack.Success())
+
+	expectedAck := &channeltypes.Acknowledgement{}
+	require.NoError(t, cdc.UnmarshalJSON(ack.Acknowledgement(), expectedAck))
+	require.Contains(t, expectedAck.GetError(), types.ErrRouteNotFound.Error())
+}
+
+func TestOnRecvPacket_ForwardUnwind(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	forwardMiddleware := setup.ForwardMiddleware
+
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	senderAccAddr := test.AccAddress()
+	testCoin := sdk.NewCoin(denom, sdk.NewInt(100))
+	metadata := &types.PacketMetadata{Forward: &types.ForwardMetadata{
+		Receiver: destAddr,
+		Unwind:   true,
+	}}
+	packetOrig := transferPacket(t, senderAddr, hostAddr, metadata)
+	packetModifiedSender := transferPacket(t, senderAddr, intermediateAddr, nil)
+
+	// Expected mocks
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetModifiedSender, senderAccAddr).
+			Return(channeltypes.NewResultAcknowledgement([]byte("test"))),
+
+		// the voucher is sent back over the channel it was received on.
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+			sdk.WrapSDKContext(ctx),
+			transfertypes.NewMsgTransfer(
+				testDestinationPort,
+				testDestinationChannel,
+				testCoin,
+				intermediateAddr,
+				destAddr,
+				keeper.DefaultTransferPacketTimeoutHeight,
+				uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
+				"",
+			),
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 0}, nil),
+	)
+
+	// chain B with packetforward module receives packet and forwards. ack should be nil so that it is not written yet.
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.Nil(t, ack)
+
+	// unwind cannot be combined with an explicit channel.
+	metadata.Forward.Port = port
+	metadata.Forward.Channel = channel
+	ack = forwardMiddleware.OnRecvPacket(ctx, transferPacket(t, senderAddr, hostAddr, metadata), senderAccAddr)
+	require.False(t, ack.Success())
+}
+
+func TestOnRecvPacketWithOptions(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	forwardMiddleware := setup.ForwardMiddleware
+	pfmKeeper := setup.Keepers.PacketForwardKeeper
+
+	senderAccAddr := test.AccAddress()
+	metadata := &types.PacketMetadata{Forward: &types.ForwardMetadata{
+		Receiver: destAddr,
+		Port:     port,
+		Channel:  channel,
+	}}
+	packetOrig := transferPacket(t, senderAddr, hostAddr, metadata)
+	opts := types.ForwardOptions{Nonrefundable: true, DisableDenomComposition: true, Processed: true}
+
+	// the funds were received by the calling middleware, so the packet is not passed to the underlying app,
+	// and the denom of the packet is forwarded as is.
+	setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+		sdk.WrapSDKContext(ctx),
+		transfertypes.NewMsgTransfer(
+			port,
+			channel,
+			sdk.NewCoin(testDenom, sdk.NewInt(100)),
+			intermediateAddr,
+			destAddr,
+			keeper.DefaultTransferPacketTimeoutHeight,
+			uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
+			"",
+		),
+	).Return(&transfertypes.MsgTransferResponse{Sequence: 1}, nil)
+
+	ack := forwardMiddleware.OnRecvPacketWithOptions(ctx, packetOrig, senderAccAddr, opts)
+	require.Nil(t, ack)
+
+	inFlightPacket := pfmKeeper.GetAndClearInFlightPacket(ctx, channel, port, 1)
+	require.NotNil(t, inFlightPacket)
+	require.True(t, inFlightPacket.Nonrefundable)
+
+	var forwardEvent *sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		event := event
+		if event.Type == types.EventTypeForward {
+			forwardEvent = &event
+		}
+	}
+	require.NotNil(t, forwardEvent)
+	require.Contains(t, forwardEvent.Attributes, abci.EventAttribute{Key: types.AttributeKeyForwardOptions, Value: opts.String()})
+
+	// processed packets without forward metadata are still passed to the underlying app.
+	packetNoForward := transferPacket(t, senderAddr, hostAddr, nil)
+	setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetNoForward, senderAccAddr).
+		Return(channeltypes.NewResultAcknowledgement([]byte("test")))
+	ack = forwardMiddleware.OnRecvPacketWithOptions(ctx, packetNoForward, senderAccAddr, opts)
+	require.True(t, ack.Success())
+	require.Equal(t, channeltypes.NewResultAcknowledgement([]byte("test")), ack)
+
+	// invalid options are rejected.
+	ack = forwardMiddleware.OnRecvPacketWithOptions(ctx, packetOrig, senderAccAddr, types.ForwardOptions{DisableDenomComposition: true})
+	require.False(t, ack.Success())
+
+	// invalid options only apply to forwards, packets without forward metadata are still passed to the underlying app.
+	setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetNoForward, senderAccAddr).
+		Return(channeltypes.NewResultAcknowledgement([]byte("test")))
+	ack = forwardMiddleware.OnRecvPacketWithOptions(ctx, packetNoForward, senderAccAddr, types.ForwardOptions{DisableDenomComposition: true})
+	require.True(t, ack.Success())
+}
+
+func TestOnRecvPacket_DeprecatedContextKeys(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	forwardMiddleware := setup.ForwardMiddleware
+	pfmKeeper := setup.Keepers.PacketForwardKeeper
+
+	goCtx := context.WithValue(setup.Initializer.Ctx.Context(), types.NonrefundableKey{}, true)
+	goCtx = context.WithValue(goCtx, types.DisableDenomCompositionKey{}, true)
+	goCtx = context.WithValue(goCtx, types.ProcessedKey{}, true)
+	ctx := setup.Initializer.Ctx.WithContext(goCtx)
+
+	senderAccAddr := test.AccAddress()
+	metadata := &types.PacketMetadata{Forward: &types.ForwardMetadata{
+		Receiver: destAddr,
+		Port:     port,
+		Channel:  channel,
+	}}
+	packetOrig := transferPacket(t, senderAddr, hostAddr, metadata)
+
+	// the context values are mapped into forward options, so the denom is forwarded as is without receiving funds.
+	setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+		sdk.WrapSDKContext(ctx),
+		transfertypes.NewMsgTransfer(
+			port,
+			channel,
+			sdk.NewCoin(testDenom, sdk.NewInt(100)),
+			intermediateAddr,
+			destAddr,
+			keeper.DefaultTransferPacketTimeoutHeight,
+			uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
+			"",
+		),
+	).Return(&transfertypes.MsgTransferResponse{Sequence: 1}, nil)
+
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.Nil(t, ack)
+
+	inFlightPacket := pfmKeeper.GetAndClearInFlightPacket(ctx, channel, port, 1)
+	require.NotNil(t, inFlightPacket)
+	require.True(t, inFlightPacket.Nonrefundable)
+}
+
+func TestOnRecvPacket_DeprecatedContextKeysWithoutForward(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	forwardMiddleware := setup.ForwardMiddleware
+
+	// denom composition is disabled without the packet being processed, which is invalid for a forward.
+	goCtx := context.WithValue(setup.Initializer.Ctx.Context(), types.DisableDenomCompositionKey{}, true)
+	ctx := setup.Initializer.Ctx.WithContext(goCtx)
+
+	senderAccAddr := test.AccAddress()
+	packet := transferPacket(t, senderAddr, hostAddr, nil)
+
+	// the packet is not forwarded, so it is passed to the underlying app regardless of the context values.
+	setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packet, senderAccAddr).
+		Return(channeltypes.NewResultAcknowledgement([]byte("test")))
+
+	ack := forwardMiddleware.OnRecvPacket(ctx, packet, senderAccAddr)
+	require.True(t, ack.Success())
+	require.Equal(t, channeltypes.NewResultAcknowledgement([]byte("test")), ack)
+}
+
+func TestOnAcknowledgementPacket_ForwardErrorRefundToEscrow(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	cdc := setup.Initializer.Marshaler
+	forwardMiddleware := setup.ForwardMiddleware
+
+	senderAccAddr := test.AccAddress()
+	chanCap := capabilitytypes.NewCapability(1)
+
+	// uatom is native to this chain, so it is unwound on receive and escrowed again when forwarded.
+	returningDenom := transfertypes.GetDenomPrefix(testSourcePort, testSourceChannel) + testDenom
+	testCoin := sdk.NewCoin(testDenom, sdk.NewInt(100))
+
+	metadata := &types.PacketMetadata{Forward: &types.ForwardMetadata{
+		Receiver: destAddr,
+		Port:     port,
+		Channel:  channel,
+	}}
+	memo, err := json.Marshal(metadata)
+	require.NoError(t, err)
+
+	packetData := func(denom, sender, receiver, memo string) []byte {
+		return transfertypes.ModuleCdc.MustMarshalJSON(&transfertypes.FungibleTokenPacketData{
+			Denom:    denom,
+			Amount:   testAmount,
+			Sender:   sender,
+			Receiver: receiver,
+			Memo:     memo,
+		})
+	}
+
+	packetOrig := channeltypes.Packet{
+		SourcePort:         testSourcePort,
+		SourceChannel:      testSourceChannel,
+		DestinationPort:    testDestinationPort,
+		DestinationChannel: testDestinationChannel,
+		Data:               packetData(returningDenom, senderAddr, hostAddr, string(memo)),
+	}
+	packetModifiedSender := packetOrig
+	packetModifiedSender.Data = packetData(returningDenom, senderAddr, intermediateAddr, "")
+
+	packetFwd := channeltypes.Packet{
+		Sequence:           1,
+		SourcePort:         port,
+		SourceChannel:      channel,
+		DestinationPort:    port,
+		DestinationChannel: "channel-100",
+		Data:               packetData(testDenom, intermediateAddr, destAddr, ""),
+	}
+
+	errorAck := channeltypes.NewErrorAcknowledgement(fmt.Errorf("test"))
+	errorAckBz := cdc.MustMarshalJSON(&errorAck)
+
+	// Expected mocks
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetModifiedSender, senderAccAddr).
+			Return(channeltypes.NewResultAcknowledgement([]byte("test"))),
+
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+			sdk.WrapSDKContext(ctx),
+			transfertypes.NewMsgTransfer(
+				port,
+				channel,
+				testCoin,
+				intermediateAddr,
+				destAddr,
+				keeper.DefaultTransferPacketTimeoutHeight,
+				uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
+				"",
+			),
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 1}, nil),
+
+		setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(ctx, testDestinationPort, testDestinationChannel).
+			Return(transfertypes.ModuleName, chanCap, nil),
+		setup.Mocks.ChannelKeeperMock.EXPECT().GetChannel(ctx, testDestinationPort, testDestinationChannel).
+			Return(channeltypes.Channel{State: channeltypes.OPEN}, true),
+
+		// the funds move between escrow accounts, so the total escrow is left untouched.
+		setup.Mocks.BankKeeperMock.EXPECT().SendCoins(
+			ctx,
+			transfertypes.GetEscrowAddress(port, channel),
+			transfertypes.GetEscrowAddress(testDestinationPort, testDestinationChannel),
+			sdk.NewCoins(testCoin),
+		).Return(nil),
+
+		setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(ctx, chanCap, gomock.Any(), errorAck).
+			Return(nil),
+	)
+
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.Nil(t, ack)
+
+	err = forwardMiddleware.OnAcknowledgementPacket(ctx, packetFwd, errorAckBz, senderAccAddr)
+	require.NoError(t, err)
+}
+
+func TestOnTimeoutPacket_RetryReplacesInFlightPacket(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	forwardMiddleware := setup.ForwardMiddleware
+
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	senderAccAddr := test.AccAddress()
+	testCoin := sdk.NewCoin(denom, sdk.NewInt(100))
+	retries := uint8(1)
+	metadata := &types.PacketMetadata{Forward: &types.ForwardMetadata{
+		Receiver: destAddr,
+		Port:     port,
+		Channel:  channel,
+		Retries:  &retries,
+	}}
+	packetOrig := transferPacket(t, senderAddr, hostAddr, metadata)
+	packetModifiedSender := transferPacket(t, senderAddr, intermediateAddr, nil)
+
+	packetFwd := channeltypes.Packet{
+		Sequence:           1,
+		SourcePort:         port,
+		SourceChannel:      channel,
+		DestinationPort:    port,
+		DestinationChannel: "channel-100",
+		Data: transfertypes.ModuleCdc.MustMarshalJSON(&transfertypes.FungibleTokenPacketData{
+			Denom:    transfertypes.GetPrefixedDenom(testDestinationPort, testDestinationChannel, testDenom),
+			Amount:   testAmount,
+			Sender:   intermediateAddr,
+			Receiver: destAddr,
+		}),
+	}
+
+	msgTransfer := transfertypes.NewMsgTransfer(
+		port,
+		channel,
+		testCoin,
+		intermediateAddr,
+		destAddr,
+		keeper.DefaultTransferPacketTimeoutHeight,
+		uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
+		"",
+	)
+
+	// Expected mocks
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetModifiedSender, senderAccAddr).
+			Return(channeltypes.NewResultAcknowledgement([]byte("test"))),
+
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(sdk.WrapSDKContext(ctx), msgTransfer).
+			Return(&transfertypes.MsgTransferResponse{Sequence: 1}, nil),
+
+		// the forward channel is unordered, so it stays open after the timeout.
+		setup.Mocks.ChannelKeeperMock.EXPECT().GetChannel(ctx, port, channel).
+			Return(channeltypes.Channel{State: channeltypes.OPEN, Ordering: channeltypes.UNORDERED}, true),
+		setup.Mocks.ChannelKeeperMock.EXPECT().GetChannel(ctx, port, channel).
+			Return(channeltypes.Channel{State: channeltypes.OPEN, Ordering: channeltypes.UNORDERED}, true),
+
+		setup.Mocks.IBCModuleMock.EXPECT().OnTimeoutPacket(ctx, packetFwd, senderAccAddr).
+			Return(nil),
+
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(sdk.WrapSDKContext(ctx), msgTransfer).
+			Return(&transfertypes.MsgTransferResponse{Sequence: 2}, nil),
+	)
+
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.Nil(t, ack)
+
+	err := forwardMiddleware.OnTimeoutPacket(ctx, packetFwd, senderAccAddr)
+	require.NoError(t, err)
+
+	// only the retried packet is in flight.
+	inFlightPackets := setup.Keepers.PacketForwardKeeper.ExportGenesis(ctx).InFlightPackets
+	require.Len(t, inFlightPackets, 1)
+	require.Equal(t, uint64(2), inFlightPackets[0].Sequence)
+	require.Equal(t, int32(0), inFlightPackets[0].InFlightPacket.RetriesRemaining)
+}
+
+func TestOnChanCloseConfirm_RefundsOnTimeoutOnClose(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	forwardMiddleware := setup.ForwardMiddleware
+
+	senderAccAddr := test.AccAddress()
+	chanCap := capabilitytypes.NewCapability(1)
+
+	// uatom is native to this chain, so it is unwound on receive and escrowed again when forwarded.
+	returningDenom := transfertypes.GetDenomPrefix(testSourcePort, testSourceChannel) + testDenom
+	testCoin := sdk.NewCoin(testDenom, sdk.NewInt(100))
+
+	retries := uint8(1)
+	metadata := &types.PacketMetadata{Forward: &types.ForwardMetadata{
+		Receiver: destAddr,
+		Port:     port,
+		Channel:  channel,
+		Retries:  &retries,
+	}}
+	memo, err := json.Marshal(metadata)
+	require.NoError(t, err)
+
+	packetData := func(denom, sender, receiver, memo string) []byte {
+		return transfertypes.ModuleCdc.MustMarshalJSON(&transfertypes.FungibleTokenPacketData{
+			Denom:    denom,
+			Amount:   testAmount,
+			Sender:   sender,
+			Receiver: receiver,
+			Memo:     memo,
+		})
+	}
+
+	packetOrig := channeltypes.Packet{
+		SourcePort:         testSourcePort,
+		SourceChannel:      testSourceChannel,
+		DestinationPort:    testDestinationPort,
+		DestinationChannel: testDestinationChannel,
+		Data:               packetData(returningDenom, senderAddr, hostAddr, string(memo)),
+	}
+	packetModifiedSender := packetOrig
+	packetModifiedSender.Data = packetData(returningDenom, senderAddr, intermediateAddr, "")
+
+	packetFwd := channeltypes.Packet{
+		Sequence:           1,
+		SourcePort:         port,
+		SourceChannel:      channel,
+		DestinationPort:    port,
+		DestinationChannel: "channel-100",
+		Data:               packetData(testDenom, intermediateAddr, destAddr, ""),
+	}
+
+	// Expected mocks
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetModifiedSender, senderAccAddr).
+			Return(channeltypes.NewResultAcknowledgement([]byte("test"))),
+
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+			sdk.WrapSDKContext(ctx),
+			transfertypes.NewMsgTransfer(
+				port,
+				channel,
+				testCoin,
+				intermediateAddr,
+				destAddr,
+				keeper.DefaultTransferPacketTimeoutHeight,
+				uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
+				"",
+			),
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 1}, nil),
+
+		setup.Mocks.IBCModuleMock.EXPECT().OnChanCloseConfirm(ctx, port, channel).
+			Return(nil),
+
+		// the forwarded packet times out on close, which proves it was not received, and is not retried over the
+		// closed channel.
+		setup.Mocks.ChannelKeeperMock.EXPECT().GetChannel(ctx, port, channel).
+			Return(channeltypes.Channel{State: channeltypes.CLOSED, Ordering: channeltypes.UNORDERED}, true),
+		setup.Mocks.ChannelKeeperMock.EXPECT().GetChannel(ctx, port, channel).
+			Return(channeltypes.Channel{State: channeltypes.CLOSED, Ordering: channeltypes.UNORDERED}, true),
+
+		setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(ctx, testDestinationPort, testDestinationChannel).
+			Return(transfertypes.ModuleName, chanCap, nil),
+		setup.Mocks.ChannelKeeperMock.EXPECT().GetChannel(ctx, testDestinationPort, testDestinationChannel).
+			Return(channeltypes.Channel{State: channeltypes.OPEN}, true),
+
+		setup.Mocks.BankKeeperMock.EXPECT().SendCoins(
+			ctx,
+			transfertypes.GetEscrowAddress(port, channel),
+			transfertypes.GetEscrowAddress(testDestinationPort, testDestinationChannel),
+			sdk.NewCoins(testCoin),
+		).Return(nil),
+
+		setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(ctx, chanCap, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ sdk.Context, _ *capabilitytypes.Capability, _ ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
+				require.False(t, ack.Success())
+				return nil
+			}),
+	)
+
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.Nil(t, ack)
+
+	// the forwarded packet may have been received before the channel was closed, so it is only reported on close.
+	err = forwardMiddleware.OnChanCloseConfirm(ctx, port, channel)
+	require.NoError(t, err)
+
+	var closedEvents int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeInFlightPacketChannelClosed {
+			closedEvents++
+		}
+	}
+	require.Equal(t, 1, closedEvents)
+
+	inFlightPackets := setup.Keepers.PacketForwardKeeper.ExportGenesis(ctx).InFlightPackets
+	require.Len(t, inFlightPackets, 1)
+	require.False(t, inFlightPackets[0].InFlightPacket.Resolved)
+
+	err = forwardMiddleware.OnTimeoutPacket(ctx, packetFwd, senderAccAddr)
+	require.NoError(t, err)
+
+	require.Empty(t, setup.Keepers.PacketForwardKeeper.ExportGenesis(ctx).InFlightPackets)
+}
+
+func TestOnRecvPacket_ForwardWithRelayerFee(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	cdc := setup.Initializer.Marshaler
+	forwardMiddleware := setup.ForwardMiddleware
+	pfmKeeper := setup.Keepers.PacketForwardKeeper
+
+	params := pfmKeeper.GetParams(ctx)
+	params.FeeSummaryAcks = true
+	require.NoError(t, pfmKeeper.SetParams(ctx, params))
+
+	denom := makeIBCDenom(testDestinationPort, testDestinationChannel, testDenom)
+	senderAccAddr := test.AccAddress()
+	chanCap := capabilitytypes.NewCapability(1)
+
+	metadata := &types.PacketMetadata{Forward: &types.ForwardMetadata{
+		Receiver: destAddr,
+		Port:     port,
+		Channel:  channel,
+		RelayerFee: &types.RelayerFeeMetadata{
+			RecvFee:    "10",
+			AckFee:     "5",
+			TimeoutFee: "5",
+		},
+	}}
+	packetOrig := transferPacket(t, senderAddr, hostAddr, metadata)
+	packetModifiedSender := transferPacket(t, senderAddr, intermediateAddr, nil)
+
+	fwdData := transfertypes.FungibleTokenPacketData{
+		Denom:    transfertypes.GetPrefixedDenom(testDestinationPort, testDestinationChannel, testDenom),
+		Amount:   "80",
+		Sender:   intermediateAddr,
+		Receiver: destAddr,
+	}
+	packetFwd := channeltypes.Packet{
+		Sequence:           1,
+		SourcePort:         port,
+		SourceChannel:      channel,
+		DestinationPort:    port,
+		DestinationChannel: "channel-100",
+		Data:               transfertypes.ModuleCdc.MustMarshalJSON(&fwdData),
+	}
+
+	acknowledgement := channeltypes.NewResultAcknowledgement([]byte("test"))
+	successAck := cdc.MustMarshalJSON(&acknowledgement)
+
+	fee := feetypes.NewFee(
+		sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(10))),
+		sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(5))),
+		sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(5))),
+	)
+
+	// the acknowledgement written for the original packet summarizes the relayer fee paid on this chain.
+	feeSummary, err := types.AddHopFees(acknowledgement.GetResult(), types.HopFees{
+		PortID:     testDestinationPort,
+		ChannelID:  testDestinationChannel,
+		RelayerFee: fee.RecvFee.Add(fee.AckFee...),
+	})
+	require.NoError(t, err)
+
+	// Expected mocks
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetModifiedSender, senderAccAddr).
+			Return(acknowledgement),
+
+		setup.Mocks.FeeKeeperMock.EXPECT().PayPacketFee(
+			sdk.WrapSDKContext(ctx),
+			feetypes.NewMsgPayPacketFee(fee, port, channel, intermediateAddr, nil),
+		).Return(&feetypes.MsgPayPacketFeeResponse{}, nil),
+
+		// the relayer fee is paid out of the forwarded amount.
+		setup.Mocks.TransferKeeperMock.EXPECT().Transfer(
+			sdk.WrapSDKContext(ctx),
+			transfertypes.NewMsgTransfer(
+				port,
+				channel,
+				sdk.NewCoin(denom, sdk.NewInt(80)),
+				intermediateAddr,
+				destAddr,
+				keeper.DefaultTransferPacketTimeoutHeight,
+				uint64(ctx.BlockTime().UnixNano())+uint64(keeper.DefaultForwardTransferPacketTimeoutTimestamp.Nanoseconds()),
+				"",
+			),
+		).Return(&transfertypes.MsgTransferResponse{Sequence: 1}, nil),
+
+		// the unused timeout fee is moved to the receiver on this chain.
+		setup.Mocks.BankKeeperMock.EXPECT().SendCoins(
+			ctx,
+			sdk.MustAccAddressFromBech32(intermediateAddr),
+			sdk.MustAccAddressFromBech32(hostAddr),
+			fee.TimeoutFee,
+		).Return(nil),
+
+		setup.Mocks.ChannelKeeperMock.EXPECT().LookupModuleByChannel(ctx, testDestinationPort, testDestinationChannel).
+			Return(transfertypes.ModuleName, chanCap, nil),
+		setup.Mocks.ChannelKeeperMock.EXPECT().GetChannel(ctx, testDestinationPort, testDestinationChannel).
+			Return(channeltypes.Channel{State: channeltypes.OPEN}, true),
+
+		setup.Mocks.ICS4WrapperMock.EXPECT().WriteAcknowledgement(
+			ctx, chanCap, gomock.Any(), channeltypes.NewResultAcknowledgement(feeSummary),
+		).Return(nil),
+	)
+
+	// chain B with packetforward module receives packet and forwards. ack should be nil so that it is not written yet.
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.Nil(t, ack)
+
+	// ack returned from chain C
+	err = forwardMiddleware.OnAcknowledgementPacket(ctx, packetFwd, successAck, senderAccAddr)
+	require.NoError(t, err)
+}
+
+func TestOnRecvPacket_ForwardWithRelayerFeeUnsupported(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	cdc := setup.Initializer.Marshaler
+	forwardMiddleware := setup.ForwardMiddleware
+
+	setup.Keepers.PacketForwardKeeper.SetFeeKeeper(nil)
+
+	senderAccAddr := test.AccAddress()
+	metadata := &types.PacketMetadata{Forward: &types.ForwardMetadata{
+		Receiver: destAddr,
+		Port:     port,
+		Channel:  channel,
+		RelayerFee: &types.RelayerFeeMetadata{
+			RecvFee: "10",
+		},
+	}}
+	packetOrig := transferPacket(t, senderAddr, hostAddr, metadata)
+	packetModifiedSender := transferPacket(t, senderAddr, intermediateAddr, nil)
+
+	// Expected mocks
+	gomock.InOrder(
+		setup.Mocks.IBCModuleMock.EXPECT().OnRecvPacket(ctx, packetModifiedSender, senderAccAddr).
+			Return(channeltypes.NewResultAcknowledgement([]byte("test"))),
+	)
+
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.False(t, ack.Success())
+
+	expectedAck := &channeltypes.Acknowledgement{}
+	err := cdc.UnmarshalJSON(ack.Acknowledgement(), expectedAck)
+	require.NoError(t, err)
+	require.Contains(t, expectedAck.GetError(), types.ErrRelayerFeeUnsupported.Error())
+}
+
+func TestOnRecvPacket_ForwardOutOfGas(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	setup := test.NewTestSetup(t, ctl)
+	ctx := setup.Initializer.Ctx
+	cdc := setup.Initializer.Marshaler
+	forwardMiddleware := setup.ForwardMiddleware
+
+	const maxForwardGas = 1000
+	params := types.DefaultParams().WithMaxForwardGas(maxForwardGas)
+	require.NoError(t, setup.Keepers.PacketForwardKeeper.SetParams(ctx, params))
+
+	senderAccAddr := test.AccAddress()
+	metadata := &types.PacketMetadata{Forward: &types.ForwardMetadata{
+		Receiver: destAddr,
+		Port:     port,
+		Channel:  channel,
+	}}
+	packetOrig := transferPacket(t, senderAddr, hostAddr, metadata)
+
+	// the memo alone exceeds the gas limit, so the funds are never received.
+	gasBefore := ctx.GasMeter().GasConsumed()
+	ack := forwardMiddleware.OnRecvPacket(ctx, packetOrig, senderAccAddr)
+	require.False(t, ack.Success())
+	require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed()-gasBefore, uint64(maxForwardGas))
+
+	expectedAck := &channeltypes.Acknowledgement{}
+	err := cdc.UnmarshalJSON(ack.Acknowledgement(), expectedAck)
+	require.NoError(t, err)
+	require.Equal(t, "packet-forward-middleware error: limit 1000: forward exceeded gas limit", expectedAck.GetError())
+}
+
+func TestValidateGenesis_InvalidInFlightPackets(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(packetforward.AppModuleBasic{}).Codec
+
+	genesis := types.DefaultGenesisState()
+	genesis.InFlightPackets = []types.InFlightPacketEntry{
+		types.NewInFlightPacketEntry(port, channel, 1, types.InFlightPacket{
+			OriginalSenderAddress: senderAddr,
+			RefundPortId:          testDestinationPort,
+			RefundChannelId:       testDestinationChannel,
+			RefundSequence:        1,
+			PacketSrcPortId:       testSourcePort,
+			PacketSrcChannelId:    testSourceChannel,
+			PacketTimeoutHeight:   "0-0",
+			PacketData:            transfertypes.NewFungibleTokenPacketData(testDenom, "100", senderAddr, hostAddr, "").GetBytes(),
+		}),
+	}
+	require.NoError(t, packetforward.AppModuleBasic{}.ValidateGenesis(cdc, nil, cdc.MustMarshalJSON(genesis)))
+
+	// the timeout height would panic once the original packet is acknowledged.
+	valid := genesis.InFlightPackets[0]
+	invalidHeight := types.NewInFlightPacketEntry(port, channel, 2, valid.InFlightPacket)
+	invalidHeight.InFlightPacket.PacketTimeoutHeight = "invalid"
+	noData := types.NewInFlightPacketEntry(port, channel, 3, valid.InFlightPacket)
+	noData.InFlightPacket.PacketData = nil
+	// the original packet data would be refunded to no one.
+	emptyData := types.NewInFlightPacketEntry(port, channel, 4, valid.InFlightPacket)
+	emptyData.InFlightPacket.PacketData = []byte("{}")
+	genesis.InFlightPackets = append(genesis.InFlightPackets, invalidHeight, noData, emptyData)
+
+	err := packetforward.AppModuleBasic{}.ValidateGenesis(cdc, nil, cdc.MustMarshalJSON(genesis))
+	require.ErrorContains(t, err, "in_flight_packets[1] transfer/channel-0/2: invalid in-flight packet timeout height")
+	require.ErrorContains(t, err, "in_flight_packets[2] transfer/channel-0/3: in-flight packet data cannot be empty")
+	require.ErrorContains(t, err, "in_flight_packets[3] transfer/channel-0/4: invalid in-flight packet data")
+}
@@ -0,0 +1,68 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding packetforward type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key, types.ParamsKey):
+			var paramsA, paramsB types.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
+		case bytes.Equal(kvA.Key, types.PauseStateKey):
+			var pauseStateA, pauseStateB types.PauseState
+			cdc.MustUnmarshal(kvA.Value, &pauseStateA)
+			cdc.MustUnmarshal(kvB.Value, &pauseStateB)
+			return fmt.Sprintf("%v\n%v", pauseStateA, pauseStateB)
+
+		case bytes.HasPrefix(kvA.Key, types.InFlightPacketKeyPrefix):
+			var inFlightPacketA, inFlightPacketB types.InFlightPacket
+			cdc.MustUnmarshal(kvA.Value, &inFlightPacketA)
+			cdc.MustUnmarshal(kvB.Value, &inFlightPacketB)
+			return fmt.Sprintf("%v\n%v", inFlightPacketA, inFlightPacketB)
+
+		case bytes.HasPrefix(kvA.Key, types.InFlightPacketBySenderKeyPrefix),
+			bytes.HasPrefix(kvA.Key, types.InFlightPacketByRefundChannelKeyPrefix):
+			// index entries have no value, the indexed packet is part of the key.
+			return fmt.Sprintf("%X\n%X", kvA.Key, kvB.Key)
+
+		case bytes.HasPrefix(kvA.Key, types.RouteKeyPrefix):
+			var routeA, routeB types.Route
+			cdc.MustUnmarshal(kvA.Value, &routeA)
+			cdc.MustUnmarshal(kvB.Value, &routeB)
+			return fmt.Sprintf("%v\n%v", routeA, routeB)
+
+		case bytes.HasPrefix(kvA.Key, types.ReceiptKeyPrefix):
+			var receiptA, receiptB types.ForwardReceipt
+			cdc.MustUnmarshal(kvA.Value, &receiptA)
+			cdc.MustUnmarshal(kvB.Value, &receiptB)
+			return fmt.Sprintf("%v\n%v", receiptA, receiptB)
+
+		case bytes.HasPrefix(kvA.Key, types.ReceiptBySenderKeyPrefix),
+			bytes.HasPrefix(kvA.Key, types.ReceiptByHeightKeyPrefix):
+			// index entries have no value, the indexed receipt is part of the key.
+			return fmt.Sprintf("%X\n%X", kvA.Key, kvB.Key)
+
+		case bytes.HasPrefix(kvA.Key, types.PendingRefundKeyPrefix):
+			var refundA, refundB types.PendingRefund
+			cdc.MustUnmarshal(kvA.Value, &refundA)
+			cdc.MustUnmarshal(kvB.Value, &refundB)
+			return fmt.Sprintf("%v\n%v", refundA, refundB)
+
+		default:
+			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/simulation"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	dec := simulation.NewDecodeStore(cdc)
+
+	params := types.DefaultParams()
+	inFlightPacket := types.InFlightPacket{OriginalSenderAddress: "sender", RefundChannelId: "channel-1"}
+	route := types.NewRoute("route-0", types.NewRouteHop("transfer", "channel-0"))
+	inFlightPacketKey := types.NewInFlightPacketKey("channel-0", "transfer", 1)
+	indexKey := encodeKey(t, types.InFlightPacketBySenderKeyPrefix,
+		collections.PairKeyCodec(collections.StringKey, types.InFlightPacketKeyCodec), collections.Join("sender", inFlightPacketKey))
+	receipt := types.ForwardReceipt{OriginalSender: "sender", PortId: "transfer", ChannelId: "channel-0", Sequence: 1}
+	receiptIndexKey := types.ReceiptByHeightKey(1, "transfer", "channel-0", 1)
+	refund := types.PendingRefund{PortId: "transfer", ChannelId: "channel-1", Sequence: 2, OriginalSenderAddress: "sender"}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.ParamsKey, Value: cdc.MustMarshal(&params)},
+			{Key: encodeKey(t, types.InFlightPacketKeyPrefix, types.InFlightPacketKeyCodec, inFlightPacketKey), Value: cdc.MustMarshal(&inFlightPacket)},
+			{Key: indexKey, Value: []byte{}},
+			{Key: types.RouteKey(route.Name), Value: cdc.MustMarshal(&route)},
+			{Key: types.ReceiptKey("transfer", "channel-0", 1), Value: cdc.MustMarshal(&receipt)},
+			{Key: receiptIndexKey, Value: []byte{}},
+			{Key: types.PendingRefundKey("transfer", "channel-1", 2), Value: cdc.MustMarshal(&refund)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Params", fmt.Sprintf("%v\n%v", params, params)},
+		{"InFlightPacket", fmt.Sprintf("%v\n%v", inFlightPacket, inFlightPacket)},
+		{"InFlightPacketIndex", fmt.Sprintf("%X\n%X", indexKey, indexKey)},
+		{"Route", fmt.Sprintf("%v\n%v", route, route)},
+		{"ForwardReceipt", fmt.Sprintf("%v\n%v", receipt, receipt)},
+		{"ForwardReceiptIndex", fmt.Sprintf("%X\n%X", receiptIndexKey, receiptIndexKey)},
+		{"PendingRefund", fmt.Sprintf("%v\n%v", refund, refund)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
+
+// encodeKey returns the store key of a collection entry under the given prefix.
+func encodeKey[K any](t *testing.T, prefix []byte, keyCodec collcodec.KeyCodec[K], key K) []byte {
+	t.Helper()
+
+	bz := make([]byte, keyCodec.Size(key))
+	_, err := keyCodec.Encode(bz, key)
+	require.NoError(t, err)
+	return append(append([]byte{}, prefix...), bz...)
+}
@@ -0,0 +1,30 @@
+package simulation
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+)
+
+// AccountKeeper defines the account keeper expected by the simulation
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+}
+
+// BankKeeper defines the bank keeper expected by the simulation
+type BankKeeper interface {
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// ChannelKeeper defines the IBC channel keeper expected by the simulation
+type ChannelKeeper interface {
+	GetAllChannels(ctx sdk.Context) []channeltypes.IdentifiedChannel
+	GetNextChannelSequence(ctx sdk.Context) uint64
+	GetNextSequenceRecv(ctx sdk.Context, portID, channelID string) (uint64, bool)
+}
+
+// TransferKeeper defines the transfer keeper expected by the simulation
+type TransferKeeper interface {
+	GetPort(ctx sdk.Context) string
+}
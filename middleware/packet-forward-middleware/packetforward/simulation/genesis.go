@@ -0,0 +1,236 @@
+package simulation
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibctypes "github.com/cosmos/ibc-go/v7/modules/core/types"
+)
+
+// Simulation parameter constants
+const (
+	FeePercentage   = "fee_percentage"
+	MemoByteGas     = "memo_byte_gas"
+	MaxForwardGas   = "max_forward_gas"
+	Routes          = "routes"
+	InFlightPackets = "in_flight_packets"
+
+	ReceiptRetentionBlocks = "receipt_retention_blocks"
+	MaxRetries             = "max_retries"
+
+	// numMockChannels is the number of mock channels that genesis in-flight packets are forwarded over.
+	numMockChannels = 4
+	// maxMockChannelPairs is the number of loopback channel pairs opened by the simulation.
+	maxMockChannelPairs = 4
+)
+
+// GenFeePercentage randomized fee percentage between 0 and 10%.
+func GenFeePercentage(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(101)), 3)
+}
+
+// GenMemoByteGas randomized gas consumed per memo byte between 0 and 20.
+func GenMemoByteGas(r *rand.Rand) uint64 {
+	return uint64(r.Intn(21))
+}
+
+// GenMaxForwardGas randomized forward gas limit, disabled half of the time.
+func GenMaxForwardGas(r *rand.Rand) uint64 {
+	if r.Intn(2) == 0 {
+		return 0
+	}
+	return uint64(simtypes.RandIntBetween(r, 200_000, 2_000_000))
+}
+
+// GenReceiptRetentionBlocks randomized receipt retention between 1 and 50 blocks, disabled half of the time.
+func GenReceiptRetentionBlocks(r *rand.Rand) uint64 {
+	if r.Intn(2) == 0 {
+		return 0
+	}
+	return uint64(simtypes.RandIntBetween(r, 1, 51))
+}
+
+// GenMaxRetries randomized maximum retries of a forward between 0 and 10.
+func GenMaxRetries(r *rand.Rand) uint32 {
+	return uint32(r.Intn(11))
+}
+
+// GenRoutes randomized routes of up to three hops over the channels in channelIDs.
+func GenRoutes(r *rand.Rand, channelIDs []string) []types.Route {
+	routes := make([]types.Route, r.Intn(4))
+	for i := range routes {
+		hops := make([]types.RouteHop, 1+r.Intn(3))
+		for j := range hops {
+			hops[j] = types.NewRouteHop(transfertypes.PortID, channelIDs[r.Intn(len(channelIDs))])
+		}
+		routes[i] = types.NewRoute(fmt.Sprintf("route-%d", i), hops...)
+	}
+	return routes
+}
+
+// GenInFlightPackets randomized in-flight packets forwarded over the channels in channelIDs on behalf of the
+// given accounts, sorted by forwarded packet. Their forwarded tokens are left unset as nothing is escrowed for them.
+func GenInFlightPackets(r *rand.Rand, accs []simtypes.Account, channelIDs []string, genTime time.Time) []types.InFlightPacketEntry {
+	var inFlightPackets []types.InFlightPacketEntry
+	sequences := make(map[string]uint64)
+
+	for i, n := 0, r.Intn(11); i < n; i++ {
+		sender := accs[r.Intn(len(accs))]
+		receiver := accs[r.Intn(len(accs))]
+		channelID := channelIDs[r.Intn(len(channelIDs))]
+		refundChannelID := channelIDs[r.Intn(len(channelIDs))]
+
+		sequences[channelID]++
+		data := transfertypes.NewFungibleTokenPacketData(
+			sdk.DefaultBondDenom,
+			sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, 1_000_000))).String(),
+			sender.Address.String(),
+			receiver.Address.String(),
+			"",
+		)
+
+		inFlightPackets = append(inFlightPackets, types.NewInFlightPacketEntry(transfertypes.PortID, channelID, sequences[channelID], types.InFlightPacket{
+			OriginalSenderAddress:  sender.Address.String(),
+			RefundChannelId:        refundChannelID,
+			RefundPortId:           transfertypes.PortID,
+			PacketSrcChannelId:     channelID,
+			PacketSrcPortId:        transfertypes.PortID,
+			PacketTimeoutTimestamp: uint64(genTime.Add(time.Duration(simtypes.RandIntBetween(r, 1, 48)) * time.Hour).UnixNano()),
+			PacketTimeoutHeight:    "0-0",
+			PacketData:             data.GetBytes(),
+			RefundSequence:         uint64(simtypes.RandIntBetween(r, 1, 1000)),
+			RetriesRemaining:       int32(r.Intn(3)),
+			Timeout:                uint64(time.Duration(simtypes.RandIntBetween(r, 1, 24)) * time.Hour),
+			Nonrefundable:          r.Intn(4) == 0,
+		}))
+	}
+
+	types.SortInFlightPacketEntries(inFlightPackets)
+	return inFlightPackets
+}
+
+// RandomizedGenState generates a random GenesisState for the packetforward module.
+//
+// The in-flight packets are forwarded over mock channels that only hold their packet commitments. The
+// commitments are added to the IBC genesis, which is generated before this one, and the next channel
+// sequence is moved past the mock channels so that the channels opened during the simulation do not
+// reuse them.
+func RandomizedGenState(simState *module.SimulationState) {
+	var (
+		feePercentage sdk.Dec
+		memoByteGas   uint64
+		maxForwardGas uint64
+
+		receiptRetentionBlocks uint64
+		maxRetries             uint32
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, FeePercentage, &feePercentage, simState.Rand,
+		func(r *rand.Rand) { feePercentage = GenFeePercentage(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MemoByteGas, &memoByteGas, simState.Rand,
+		func(r *rand.Rand) { memoByteGas = GenMemoByteGas(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxForwardGas, &maxForwardGas, simState.Rand,
+		func(r *rand.Rand) { maxForwardGas = GenMaxForwardGas(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ReceiptRetentionBlocks, &receiptRetentionBlocks, simState.Rand,
+		func(r *rand.Rand) { receiptRetentionBlocks = GenReceiptRetentionBlocks(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxRetries, &maxRetries, simState.Rand,
+		func(r *rand.Rand) { maxRetries = GenMaxRetries(r) },
+	)
+
+	pfmGenesis := types.DefaultGenesisState()
+	pfmGenesis.Params = types.NewParams(feePercentage).
+		WithMemoByteGas(memoByteGas).
+		WithMaxForwardGas(maxForwardGas).
+		WithReceiptRetentionBlocks(receiptRetentionBlocks).
+		WithForwardRetries(types.DefaultRetries, maxRetries)
+
+	ibcGenesisBz, ok := simState.GenState[ibcexported.ModuleName]
+	if ok {
+		var ibcGenesis ibctypes.GenesisState
+		simState.Cdc.MustUnmarshalJSON(ibcGenesisBz, &ibcGenesis)
+
+		// reserve the mock channels of the in-flight packets, followed by the channels of the loopback pairs
+		// that may be opened during the simulation.
+		firstChannel := ibcGenesis.ChannelGenesis.NextChannelSequence
+		mockChannelIDs := make([]string, numMockChannels)
+		for i := range mockChannelIDs {
+			mockChannelIDs[i] = channeltypes.FormatChannelIdentifier(firstChannel + uint64(i))
+		}
+		loopbackChannelIDs := make([]string, 2*maxMockChannelPairs)
+		for i := range loopbackChannelIDs {
+			loopbackChannelIDs[i] = channeltypes.FormatChannelIdentifier(firstChannel + uint64(len(mockChannelIDs)+i))
+		}
+
+		simState.AppParams.GetOrGenerate(
+			simState.Cdc, InFlightPackets, &pfmGenesis.InFlightPackets, simState.Rand,
+			func(r *rand.Rand) {
+				pfmGenesis.InFlightPackets = GenInFlightPackets(r, simState.Accounts, mockChannelIDs, simState.GenTimestamp)
+			},
+		)
+
+		simState.AppParams.GetOrGenerate(
+			simState.Cdc, Routes, &pfmGenesis.Routes, simState.Rand,
+			func(r *rand.Rand) { pfmGenesis.Routes = GenRoutes(r, loopbackChannelIDs) },
+		)
+
+		for _, entry := range pfmGenesis.InFlightPackets {
+			commitment := sha256.Sum256(entry.InFlightPacket.PacketData)
+			ibcGenesis.ChannelGenesis.Commitments = append(
+				ibcGenesis.ChannelGenesis.Commitments,
+				channeltypes.NewPacketState(entry.PortId, entry.ChannelId, entry.Sequence, commitment[:]),
+			)
+		}
+		// sort the commitments together with the commitments already in the IBC genesis.
+		sortPacketStates(ibcGenesis.ChannelGenesis.Commitments)
+		ibcGenesis.ChannelGenesis.NextChannelSequence = firstChannel + uint64(len(mockChannelIDs))
+
+		simState.GenState[ibcexported.ModuleName] = simState.Cdc.MustMarshalJSON(&ibcGenesis)
+	}
+
+	bz, err := json.MarshalIndent(&pfmGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(pfmGenesis)
+}
+
+// sortPacketStates sorts packet states by port, channel and sequence.
+func sortPacketStates(packetStates []channeltypes.PacketState) {
+	sort.Slice(packetStates, func(i, j int) bool {
+		a, b := packetStates[i], packetStates[j]
+		if a.PortId != b.PortId {
+			return a.PortId < b.PortId
+		}
+		if a.ChannelId != b.ChannelId {
+			return a.ChannelId < b.ChannelId
+		}
+		return a.Sequence < b.Sequence
+	})
+}
@@ -0,0 +1,423 @@
+package simulation
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/keeper"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	localhost "github.com/cosmos/ibc-go/v7/modules/light-clients/09-localhost"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgOpenLoopbackChannels = "op_weight_msg_open_loopback_channels" //nolint:gosec
+	OpWeightMsgForwardTransfer      = "op_weight_msg_forward_transfer"       //nolint:gosec
+
+	DefaultWeightMsgOpenLoopbackChannels = 5
+	DefaultWeightMsgForwardTransfer      = 100
+)
+
+var (
+	encodingConfig = moduletestutil.MakeTestEncodingConfig()
+	txGen          = encodingConfig.TxConfig
+	protoCdc       = codec.NewProtoCodec(encodingConfig.InterfaceRegistry)
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights.
+//
+// The simulation runs on a single chain, so forwards are sent over loopback channel pairs on the localhost
+// connection. Every packet sent, and every acknowledgement written, by an operation is relayed by a future
+// operation, which receives the packet or times it out if it expired in the meantime.
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	k *keeper.Keeper,
+	ak AccountKeeper,
+	bk BankKeeper,
+	ck ChannelKeeper,
+	tk TransferKeeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgOpenLoopbackChannels int
+		weightMsgForwardTransfer      int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgOpenLoopbackChannels, &weightMsgOpenLoopbackChannels, nil,
+		func(_ *rand.Rand) { weightMsgOpenLoopbackChannels = DefaultWeightMsgOpenLoopbackChannels },
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgForwardTransfer, &weightMsgForwardTransfer, nil,
+		func(_ *rand.Rand) { weightMsgForwardTransfer = DefaultWeightMsgForwardTransfer },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgOpenLoopbackChannels,
+			SimulateMsgOpenLoopbackChannels(ak, ck, tk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgForwardTransfer,
+			SimulateMsgForwardTransfer(k, ak, bk, ck),
+		),
+	}
+}
+
+// SimulateMsgOpenLoopbackChannels opens a pair of transfer channels that are each other's counterparty on the
+// localhost connection, running the whole channel handshake in a single transaction.
+func SimulateMsgOpenLoopbackChannels(ak AccountKeeper, ck ChannelKeeper, tk TransferKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&channeltypes.MsgChannelOpenInit{})
+		if len(loopbackChannels(ctx, ck)) >= 2*maxMockChannelPairs {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "all loopback channels are open"), nil, nil
+		}
+
+		relayer, _ := simtypes.RandomAcc(r, accs)
+		signer := relayer.Address.String()
+		portID := tk.GetPort(ctx)
+		connectionHops := []string{ibcexported.LocalhostConnectionID}
+		proofHeight := clienttypes.GetSelfHeight(ctx)
+
+		sequence := ck.GetNextChannelSequence(ctx)
+		initChannelID := channeltypes.FormatChannelIdentifier(sequence)
+		tryChannelID := channeltypes.FormatChannelIdentifier(sequence + 1)
+
+		msgs := []sdk.Msg{
+			channeltypes.NewMsgChannelOpenInit(
+				portID, transfertypes.Version, channeltypes.UNORDERED, connectionHops, portID, signer,
+			),
+			channeltypes.NewMsgChannelOpenTry(
+				portID, transfertypes.Version, channeltypes.UNORDERED, connectionHops, portID, initChannelID,
+				transfertypes.Version, localhost.SentinelProof, proofHeight, signer,
+			),
+			channeltypes.NewMsgChannelOpenAck(
+				portID, initChannelID, tryChannelID, transfertypes.Version, localhost.SentinelProof, proofHeight, signer,
+			),
+			channeltypes.NewMsgChannelOpenConfirm(
+				portID, tryChannelID, localhost.SentinelProof, proofHeight, signer,
+			),
+		}
+
+		if _, err := deliver(r, app, ctx, ak, relayer, msgs...); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msgs[0], true, "", protoCdc), nil, nil
+	}
+}
+
+// SimulateMsgForwardTransfer transfers a random amount of a random spendable coin over a loopback channel,
+// with a memo that forwards it over up to three hops, a registered route, or back along its denom trace.
+func SimulateMsgForwardTransfer(k *keeper.Keeper, ak AccountKeeper, bk BankKeeper, ck ChannelKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&transfertypes.MsgTransfer{})
+
+		channels := loopbackChannels(ctx, ck)
+		if len(channels) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no loopback channels"), nil, nil
+		}
+
+		sender, _ := simtypes.RandomAcc(r, accs)
+		spendable := bk.SpendableCoins(ctx, sender.Address)
+		if spendable.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no spendable coins"), nil, nil
+		}
+
+		coin := spendable[r.Intn(len(spendable))]
+		amount, err := simtypes.RandPositiveInt(r, coin.Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate positive amount"), nil, nil
+		}
+
+		receiver, memo, err := randomForwardMemo(r, ctx, k, accs, channels, coin.Denom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate memo"), nil, err
+		}
+
+		channel := channels[r.Intn(len(channels))]
+		msg := transfertypes.NewMsgTransfer(
+			channel.PortId,
+			channel.ChannelId,
+			sdk.NewCoin(coin.Denom, amount),
+			sender.Address.String(),
+			receiver,
+			clienttypes.ZeroHeight(),
+			uint64(ctx.BlockTime().Add(randomTimeout(r)).UnixNano()),
+			memo,
+		)
+
+		res, err := deliver(r, app, ctx, ak, sender, msg)
+		if errors.Is(err, transfertypes.ErrSendDisabled) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "transfers are disabled"), nil, nil
+		}
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+
+		futureOps, err := relayOperations(r, ctx, ak, ck, res.Events)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to relay packets"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, "", protoCdc), futureOps, nil
+	}
+}
+
+// SimulateMsgRelayPacket receives a packet sent over a loopback channel, or times it out if it expired.
+func SimulateMsgRelayPacket(ak AccountKeeper, ck ChannelKeeper, packet channeltypes.Packet) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		relayer, _ := simtypes.RandomAcc(r, accs)
+		proofHeight := clienttypes.GetSelfHeight(ctx)
+
+		var msg sdk.Msg
+		if packetTimedOut(ctx, packet) {
+			nextSequenceRecv, _ := ck.GetNextSequenceRecv(ctx, packet.DestinationPort, packet.DestinationChannel)
+			msg = channeltypes.NewMsgTimeout(packet, nextSequenceRecv, localhost.SentinelProof, proofHeight, relayer.Address.String())
+		} else {
+			msg = channeltypes.NewMsgRecvPacket(packet, localhost.SentinelProof, proofHeight, relayer.Address.String())
+		}
+
+		return deliverAndRelay(r, app, ctx, ak, ck, relayer, msg)
+	}
+}
+
+// SimulateMsgAcknowledgement relays the acknowledgement of a packet sent over a loopback channel.
+func SimulateMsgAcknowledgement(ak AccountKeeper, ck ChannelKeeper, packet channeltypes.Packet, ack []byte) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		relayer, _ := simtypes.RandomAcc(r, accs)
+		msg := channeltypes.NewMsgAcknowledgement(
+			packet, ack, localhost.SentinelProof, clienttypes.GetSelfHeight(ctx), relayer.Address.String(),
+		)
+
+		return deliverAndRelay(r, app, ctx, ak, ck, relayer, msg)
+	}
+}
+
+// randomForwardMemo returns the receiver and memo of a transfer that forwards the token with the given denom
+// over random loopback channels. Without a memo, or with a final receiver that is not an address, the
+// transfer is received by or fails on the first or last chain respectively.
+func randomForwardMemo(
+	r *rand.Rand,
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	accs []simtypes.Account,
+	channels []channeltypes.IdentifiedChannel,
+	denom string,
+) (string, string, error) {
+	finalReceiver, _ := simtypes.RandomAcc(r, accs)
+	receiver := finalReceiver.Address.String()
+	if r.Intn(10) == 0 {
+		receiver = "invalid-receiver"
+	}
+	if r.Intn(10) == 0 {
+		return receiver, "", nil
+	}
+
+	retries := uint8(r.Intn(3))
+	metadata := &types.ForwardMetadata{
+		Receiver: receiver,
+		Retries:  &retries,
+	}
+	// without a timeout the forward timeout of the middleware applies, which is shorter than a block.
+	if r.Intn(3) != 0 {
+		metadata.Timeout = types.Duration(randomTimeout(r))
+	}
+
+	routes := k.GetAllRoutes(ctx)
+	switch {
+	case strings.HasPrefix(denom, "ibc/") && r.Intn(4) == 0:
+		metadata.Unwind = true
+	case len(routes) > 0 && r.Intn(4) == 0:
+		metadata.Route = routes[r.Intn(len(routes))].Name
+	default:
+		hops := make([]types.RouteHop, 1+r.Intn(3))
+		for i := range hops {
+			channel := channels[r.Intn(len(channels))]
+			hops[i] = types.NewRouteHop(channel.PortId, channel.ChannelId)
+		}
+		if err := metadata.ExpandRoute(types.NewRoute("", hops...)); err != nil {
+			return "", "", err
+		}
+	}
+
+	memo, err := json.Marshal(types.PacketMetadata{Forward: metadata})
+	if err != nil {
+		return "", "", err
+	}
+	return types.IntermediateReceiver, string(memo), nil
+}
+
+// randomTimeout returns a relative packet timeout between 4 and 48 hours, which is between one and a few
+// dozen simulated blocks.
+func randomTimeout(r *rand.Rand) time.Duration {
+	return time.Duration(simtypes.RandIntBetween(r, 4, 48)) * time.Hour
+}
+
+// loopbackChannels returns the open transfer channels on the localhost connection.
+func loopbackChannels(ctx sdk.Context, ck ChannelKeeper) []channeltypes.IdentifiedChannel {
+	var channels []channeltypes.IdentifiedChannel
+	for _, channel := range ck.GetAllChannels(ctx) {
+		if channel.State != channeltypes.OPEN || channel.Ordering != channeltypes.UNORDERED ||
+			channel.Version != transfertypes.Version ||
+			len(channel.ConnectionHops) != 1 || channel.ConnectionHops[0] != ibcexported.LocalhostConnectionID {
+			continue
+		}
+		channels = append(channels, channel)
+	}
+	return channels
+}
+
+// packetTimedOut returns true if the packet can no longer be received.
+func packetTimedOut(ctx sdk.Context, packet channeltypes.Packet) bool {
+	if !packet.TimeoutHeight.IsZero() && clienttypes.GetSelfHeight(ctx).GTE(packet.TimeoutHeight) {
+		return true
+	}
+	return packet.TimeoutTimestamp != 0 && uint64(ctx.BlockTime().UnixNano()) >= packet.TimeoutTimestamp
+}
+
+// deliver signs and delivers a transaction with the given messages.
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak AccountKeeper, simAccount simtypes.Account, msgs ...sdk.Msg,
+) (*sdk.Result, error) {
+	account := ak.GetAccount(ctx, simAccount.Address)
+	tx, err := simtestutil.GenSignedMockTx(
+		r,
+		txGen,
+		msgs,
+		sdk.NewCoins(),
+		simtestutil.DefaultGenTxGas,
+		ctx.ChainID(),
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simAccount.PrivKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, res, err := app.SimDeliver(txGen.TxEncoder(), tx)
+	return res, err
+}
+
+// deliverAndRelay delivers a relayer message and relays the packets and acknowledgements that result from it.
+func deliverAndRelay(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak AccountKeeper,
+	ck ChannelKeeper,
+	relayer simtypes.Account,
+	msg sdk.Msg,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	res, err := deliver(r, app, ctx, ak, relayer, msg)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to deliver tx"), nil, err
+	}
+
+	futureOps, err := relayOperations(r, ctx, ak, ck, res.Events)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to relay packets"), nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, true, "", protoCdc), futureOps, nil
+}
+
+// relayOperations returns the operations that relay the packets sent, and the acknowledgements written, over
+// the localhost connection in the given events. They run in one of the next three blocks.
+func relayOperations(
+	r *rand.Rand, ctx sdk.Context, ak AccountKeeper, ck ChannelKeeper, events []abci.Event,
+) ([]simtypes.FutureOperation, error) {
+	var futureOps []simtypes.FutureOperation
+	for _, event := range events {
+		if event.Type != channeltypes.EventTypeSendPacket && event.Type != channeltypes.EventTypeWriteAck {
+			continue
+		}
+
+		attributes := make(map[string]string)
+		for _, attribute := range event.Attributes {
+			attributes[attribute.Key] = attribute.Value
+		}
+		if attributes[channeltypes.AttributeKeyConnection] != ibcexported.LocalhostConnectionID {
+			continue
+		}
+
+		packet, err := packetFromAttributes(attributes)
+		if err != nil {
+			return nil, err
+		}
+
+		op := SimulateMsgRelayPacket(ak, ck, packet)
+		if event.Type == channeltypes.EventTypeWriteAck {
+			ack, err := hex.DecodeString(attributes[channeltypes.AttributeKeyAckHex])
+			if err != nil {
+				return nil, err
+			}
+			op = SimulateMsgAcknowledgement(ak, ck, packet, ack)
+		}
+
+		futureOps = append(futureOps, simtypes.FutureOperation{
+			BlockHeight: int(ctx.BlockHeight()) + 1 + r.Intn(3),
+			Op:          op,
+		})
+	}
+	return futureOps, nil
+}
+
+// packetFromAttributes returns the packet of a send_packet or write_acknowledgement event.
+func packetFromAttributes(attributes map[string]string) (channeltypes.Packet, error) {
+	data, err := hex.DecodeString(attributes[channeltypes.AttributeKeyDataHex])
+	if err != nil {
+		return channeltypes.Packet{}, err
+	}
+	sequence, err := strconv.ParseUint(attributes[channeltypes.AttributeKeySequence], 10, 64)
+	if err != nil {
+		return channeltypes.Packet{}, err
+	}
+	timeoutHeight, err := clienttypes.ParseHeight(attributes[channeltypes.AttributeKeyTimeoutHeight])
+	if err != nil {
+		return channeltypes.Packet{}, err
+	}
+	timeoutTimestamp, err := strconv.ParseUint(attributes[channeltypes.AttributeKeyTimeoutTimestamp], 10, 64)
+	if err != nil {
+		return channeltypes.Packet{}, err
+	}
+
+	return channeltypes.NewPacket(
+		data,
+		sequence,
+		attributes[channeltypes.AttributeKeySrcPort],
+		attributes[channeltypes.AttributeKeySrcChannel],
+		attributes[channeltypes.AttributeKeyDstPort],
+		attributes[channeltypes.AttributeKeyDstChannel],
+		timeoutHeight,
+		timeoutTimestamp,
+	), nil
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// NewChannelEquivalence creates a new group of equivalent channels on the port.
+func NewChannelEquivalence(portID string, channelIDs ...string) ChannelEquivalence {
+	return ChannelEquivalence{
+		PortId:     portID,
+		ChannelIds: channelIDs,
+	}
+}
+
+// Validate checks that the group has at least two valid and unique channels.
+func (e ChannelEquivalence) Validate() error {
+	if err := host.PortIdentifierValidator(e.PortId); err != nil {
+		return fmt.Errorf("invalid channel equivalence port: %w", err)
+	}
+	if len(e.ChannelIds) < 2 {
+		return fmt.Errorf("channel equivalence on port %s must have at least two channels", e.PortId)
+	}
+
+	seen := make(map[string]bool, len(e.ChannelIds))
+	for _, channelID := range e.ChannelIds {
+		if err := host.ChannelIdentifierValidator(channelID); err != nil {
+			return fmt.Errorf("invalid channel equivalence channel: %w", err)
+		}
+		if seen[channelID] {
+			return fmt.Errorf("duplicate channel %s in channel equivalence on port %s", channelID, e.PortId)
+		}
+		seen[channelID] = true
+	}
+	return nil
+}
+
+// hasChannel returns true if the channel belongs to the group.
+func (e ChannelEquivalence) hasChannel(channelID string) bool {
+	for _, id := range e.ChannelIds {
+		if id == channelID {
+			return true
+		}
+	}
+	return false
+}
+
+// validateChannelEquivalences checks that every group is valid and that no channel belongs to more than one group.
+func validateChannelEquivalences(equivalences []ChannelEquivalence) error {
+	seen := make(map[string]bool)
+	for _, equivalence := range equivalences {
+		if err := equivalence.Validate(); err != nil {
+			return err
+		}
+		for _, channelID := range equivalence.ChannelIds {
+			key := equivalence.PortId + "/" + channelID
+			if seen[key] {
+				return fmt.Errorf("channel %s on port %s belongs to more than one channel equivalence", channelID, equivalence.PortId)
+			}
+			seen[key] = true
+		}
+	}
+	return nil
+}
+
+// EquivalentChannels returns the channels that are equivalent to the channel on the port, in order of preference
+// and excluding the channel itself. It returns nil if the channel does not belong to a group.
+func (p Params) EquivalentChannels(portID, channelID string) []string {
+	for _, equivalence := range p.ChannelEquivalences {
+		if equivalence.PortId != portID || !equivalence.hasChannel(channelID) {
+			continue
+		}
+
+		channelIDs := make([]string, 0, len(equivalence.ChannelIds)-1)
+		for _, equivalent := range equivalence.ChannelIds {
+			if equivalent != channelID {
+				channelIDs = append(channelIDs, equivalent)
+			}
+		}
+		return channelIDs
+	}
+	return nil
+}
+
+// IsDenomTraceCompatible returns true if a token with the full denom path may fail over from the channel to the
+// equivalent channel. Tokens that return to their source over either channel are not compatible, because over the
+// other channel they would be received as a voucher instead of being unescrowed.
+func IsDenomTraceCompatible(portID, channelID, equivalentChannelID, fullDenomPath string) bool {
+	return !transfertypes.ReceiverChainIsSource(portID, channelID, fullDenomPath) &&
+		!transfertypes.ReceiverChainIsSource(portID, equivalentChannelID, fullDenomPath)
+}
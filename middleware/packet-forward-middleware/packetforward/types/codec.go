@@ -30,12 +30,20 @@ func init() {
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(Params{}, "packetforward/Params", nil)
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "packetforward/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgSetForwardingPaused{}, "packetforward/MsgSetForwardingPaused")
+	legacy.RegisterAminoMsg(cdc, &MsgSweepIntermediateAccount{}, "packetforward/MsgSweepIntermediateAcct")
+	legacy.RegisterAminoMsg(cdc, &MsgSetRoute{}, "packetforward/MsgSetRoute")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveRoute{}, "packetforward/MsgRemoveRoute")
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgSetForwardingPaused{},
+		&MsgSweepIntermediateAccount{},
+		&MsgSetRoute{},
+		&MsgRemoveRoute{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,18 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/packetforward module sentinel errors
+var (
+	ErrForwardingPaused      = errorsmod.Register(ModuleName, 2, "forwarding is paused")
+	ErrRelayerFeeUnsupported = errorsmod.Register(ModuleName, 3, "relayer fees are not supported")
+	ErrForwardOutOfGas       = errorsmod.Register(ModuleName, 4, "forward exceeded gas limit")
+	ErrNothingToSweep        = errorsmod.Register(ModuleName, 5, "intermediate account holds no funds")
+	ErrDenomNotForwardable   = errorsmod.Register(ModuleName, 6, "denom cannot be forwarded")
+	ErrForwardAmountTooLow   = errorsmod.Register(ModuleName, 7, "forward amount is below the minimum")
+	ErrRouteNotFound         = errorsmod.Register(ModuleName, 8, "route not found")
+	ErrReceiptNotFound       = errorsmod.Register(ModuleName, 9, "forward receipt not found")
+	ErrRefundPending         = errorsmod.Register(ModuleName, 10, "refund from intermediate account is pending")
+)
@@ -0,0 +1,32 @@
+package types
+
+// packetforward events
+const (
+	EventTypeInFlightPacketResolved      = "in_flight_packet_resolved"
+	EventTypeInFlightPacketChannelClosed = "in_flight_packet_channel_closed"
+	EventTypeIntermediateAccountSwept    = "intermediate_account_swept"
+	EventTypeForward                     = "forward"
+	EventTypeForwardFailover             = "forward_failover"
+	EventTypeRefundPacket                = "refund_packet"
+	EventTypeRefundRecovered             = "refund_recovered"
+
+	AttributeKeyForwardPortID         = "forward_port_id"
+	AttributeKeyForwardChannelID      = "forward_channel_id"
+	AttributeKeyForwardSequence       = "forward_sequence"
+	AttributeKeyRefundPortID          = "refund_port_id"
+	AttributeKeyRefundChannelID       = "refund_channel_id"
+	AttributeKeyRefundSequence        = "refund_sequence"
+	AttributeKeyOriginalSender        = "original_sender"
+	AttributeKeyForwardToken          = "forward_token"
+	AttributeKeyIntermediateAccount   = "intermediate_account"
+	AttributeKeyRecipient             = "recipient"
+	AttributeKeyAmount                = "amount"
+	AttributeKeyForwardOptions        = "forward_options"
+	AttributeKeyRequestedChannelID    = "requested_channel_id"
+	AttributeKeyAttemptedChannelIDs   = "attempted_channel_ids"
+	AttributeKeyRefundPacketPortID    = "refund_packet_port_id"
+	AttributeKeyRefundPacketChannelID = "refund_packet_channel_id"
+	AttributeKeyRefundPacketSequence  = "refund_packet_sequence"
+	AttributeKeyRetriesRemaining      = "retries_remaining"
+	AttributeValueCategory            = ModuleName
+)
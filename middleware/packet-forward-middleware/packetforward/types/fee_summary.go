@@ -0,0 +1,69 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeSummary is the result of a successful acknowledgement of a forward that was charged fees. It wraps the
+// result of the acknowledgement of the last hop and lists the fees charged by every forwarding chain, starting
+// with the chain that wrote the acknowledgement.
+type FeeSummary struct {
+	// Result is the result of the acknowledgement written by the last chain of the forward.
+	Result []byte `json:"result"`
+	// Hops are the fees charged by the forwarding chains in the order the packet was forwarded.
+	Hops []HopFees `json:"hops"`
+}
+
+// HopFees are the fees charged by a forwarding chain. The amounts are denominated on that chain.
+type HopFees struct {
+	// PortID is the port the forwarding chain received the packet on.
+	PortID string `json:"port_id"`
+	// ChannelID is the channel the forwarding chain received the packet on.
+	ChannelID string `json:"channel_id"`
+	// Fees are the fees paid to the community pool.
+	Fees sdk.Coins `json:"fees,omitempty"`
+	// RelayerFee is the ICS-29 relayer fee paid to the relayers of the forwarded packet.
+	RelayerFee sdk.Coins `json:"relayer_fee,omitempty"`
+}
+
+// IsZero returns true if the hop charged no fees.
+func (h HopFees) IsZero() bool {
+	return h.Fees.IsZero() && h.RelayerFee.IsZero()
+}
+
+// ParseFeeSummary parses the fee summary from the result of an acknowledgement. It returns false if the result
+// is not a fee summary, such as the result of a chain that does not forward the packet.
+func ParseFeeSummary(result []byte) (FeeSummary, bool) {
+	var summary FeeSummary
+
+	decoder := json.NewDecoder(bytes.NewReader(result))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&summary); err != nil || len(summary.Hops) == 0 {
+		return FeeSummary{}, false
+	}
+
+	return summary, true
+}
+
+// AddHopFees returns the fee summary of the result of a downstream acknowledgement with the fees of this hop
+// prepended. The result is returned unchanged if neither this hop nor the downstream hops charged fees.
+func AddHopFees(result []byte, hop HopFees) ([]byte, error) {
+	summary, ok := ParseFeeSummary(result)
+	if !ok {
+		if hop.IsZero() {
+			return result, nil
+		}
+		summary = FeeSummary{Result: result}
+	}
+	summary.Hops = append([]HopFees{hop}, summary.Hops...)
+
+	bz, err := json.Marshal(summary)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal fee summary: %w", err)
+	}
+	return bz, nil
+}
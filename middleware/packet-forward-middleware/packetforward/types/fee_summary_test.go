@@ -0,0 +1,57 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestAddHopFees(t *testing.T) {
+	result := []byte{1}
+
+	// a hop without fees keeps the result of the downstream acknowledgement.
+	bz, err := types.AddHopFees(result, types.HopFees{PortID: "transfer", ChannelID: "channel-0"})
+	require.NoError(t, err)
+	require.Equal(t, result, bz)
+	_, ok := types.ParseFeeSummary(bz)
+	require.False(t, ok)
+
+	lastHop := types.HopFees{
+		PortID:    "transfer",
+		ChannelID: "channel-1",
+		Fees:      sdk.NewCoins(sdk.NewInt64Coin("uosmo", 10)),
+	}
+	bz, err = types.AddHopFees(result, lastHop)
+	require.NoError(t, err)
+
+	// the fees of upstream hops are prepended, even if they charged no fees themselves.
+	firstHop := types.HopFees{PortID: "transfer", ChannelID: "channel-0"}
+	bz, err = types.AddHopFees(bz, firstHop)
+	require.NoError(t, err)
+
+	summary, ok := types.ParseFeeSummary(bz)
+	require.True(t, ok)
+	require.Equal(t, result, summary.Result)
+	require.Equal(t, []types.HopFees{firstHop, lastHop}, summary.Hops)
+}
+
+func TestParseFeeSummary(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		result string
+		ok     bool
+	}{
+		{"ics20 result", "\x01", false},
+		{"json without hops", `{"result":"AQ=="}`, false},
+		{"unknown fields", `{"contract_result":"AQ==","hops":[{"port_id":"transfer","channel_id":"channel-0"}]}`, false},
+		{"fee summary", `{"result":"AQ==","hops":[{"port_id":"transfer","channel_id":"channel-0","fees":[{"denom":"uatom","amount":"1"}]}]}`, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, ok := types.ParseFeeSummary([]byte(tc.result))
+			require.Equal(t, tc.ok, ok)
+		})
+	}
+}
@@ -22,11 +22,37 @@ type ForwardMetadata struct {
 	Timeout  Duration `json:"timeout,omitempty"`
 	Retries  *uint8   `json:"retries,omitempty"`
 
+	// Route optionally names a registered route to forward over instead of port and channel.
+	Route string `json:"route,omitempty"`
+
+	// Unwind optionally forwards the token back to its origin chain along its denom trace instead of over port
+	// and channel.
+	Unwind bool `json:"unwind,omitempty"`
+
+	// RelayerFee optionally incentivizes relaying of the forwarded packet through ICS-29.
+	RelayerFee *RelayerFeeMetadata `json:"relayer_fee,omitempty"`
+
+	// RetryPolicy optionally retries error acknowledgements of the forwarded packet, and sends retries over an
+	// alternate channel.
+	RetryPolicy *RetryPolicy `json:"retry_policy,omitempty"`
+
 	// Using JSONObject so that objects for next property will not be mutated by golang's lexicographic key sort on map keys during Marshal.
 	// Supports primitives for Unmarshal/Marshal so that an escaped JSON-marshaled string is also valid.
 	Next *JSONObject `json:"next,omitempty"`
+
+	// FinalMemo is the memo of the packet received on the final chain. It is passed on through the forwards of next,
+	// and merged into the memo of the last one.
+	FinalMemo *JSONObject `json:"final_memo,omitempty"`
 }
 
+const (
+	// MaxFinalMemoLength is the maximum length of the final memo of a forward.
+	MaxFinalMemoLength = 16384
+
+	// MaxForwardMemoLength is the maximum length of the memo of a forwarded packet.
+	MaxForwardMemoLength = 32768
+)
+
 type Duration time.Duration
 
 func (m *ForwardMetadata) Validate() error {
@@ -39,10 +65,97 @@ func (m *ForwardMetadata) Validate() error {
 	if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
 		return fmt.Errorf("failed to validate metadata: %w", err)
 	}
+	if m.RelayerFee != nil {
+		if err := m.RelayerFee.Validate(); err != nil {
+			return fmt.Errorf("failed to validate metadata: %w", err)
+		}
+	}
+	if m.RetryPolicy != nil {
+		if err := m.RetryPolicy.Validate(); err != nil {
+			return fmt.Errorf("failed to validate metadata: %w", err)
+		}
+	}
+	if m.FinalMemo != nil {
+		finalMemo, err := m.FinalMemo.object()
+		if err != nil {
+			return fmt.Errorf("failed to validate metadata. final memo must be a JSON object: %w", err)
+		}
+		bz, err := json.Marshal(finalMemo)
+		if err != nil {
+			return fmt.Errorf("failed to validate metadata: %w", err)
+		}
+		if len(bz) > MaxFinalMemoLength {
+			return fmt.Errorf("failed to validate metadata. final memo length %d exceeds %d", len(bz), MaxFinalMemoLength)
+		}
+	}
 
 	return nil
 }
 
+// NextMemo returns the memo of the packet forwarded to the next chain. Without a final memo it is next. A final
+// memo is passed on to the forward of next if there is one, and is otherwise merged into next, keeping the key
+// order of both.
+func (m *ForwardMetadata) NextMemo() (string, error) {
+	var (
+		bz  []byte
+		err error
+	)
+
+	switch {
+	case m.FinalMemo == nil && m.Next == nil:
+		return "", nil
+	case m.FinalMemo == nil:
+		bz, err = json.Marshal(m.Next)
+	case m.Next == nil:
+		bz, err = json.Marshal(m.FinalMemo)
+	default:
+		bz, err = m.mergeFinalMemo()
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if len(bz) > MaxForwardMemoLength {
+		return "", fmt.Errorf("memo length %d of forwarded packet exceeds %d", len(bz), MaxForwardMemoLength)
+	}
+	return string(bz), nil
+}
+
+// mergeFinalMemo sets the final memo on the forward of next, or merges its keys into next if next does not
+// forward any further.
+func (m *ForwardMetadata) mergeFinalMemo() ([]byte, error) {
+	finalMemo, err := m.FinalMemo.object()
+	if err != nil {
+		return nil, fmt.Errorf("final memo must be a JSON object: %w", err)
+	}
+	next, err := m.Next.object()
+	if err != nil {
+		return nil, fmt.Errorf("next must be a JSON object to carry a final memo: %w", err)
+	}
+
+	if value, ok := next.Get("forward"); ok {
+		forward, ok := value.(orderedmap.OrderedMap)
+		if !ok {
+			return nil, fmt.Errorf("forward of next must be a JSON object to carry a final memo")
+		}
+		if _, ok := forward.Get("final_memo"); ok {
+			return nil, fmt.Errorf("final memo cannot be set on more than one forward")
+		}
+		forward.Set("final_memo", finalMemo)
+		next.Set("forward", forward)
+	} else {
+		for _, key := range finalMemo.Keys() {
+			if _, ok := next.Get(key); ok {
+				return nil, fmt.Errorf("final memo key %s is already set in next", key)
+			}
+			value, _ := finalMemo.Get(key)
+			next.Set(key, value)
+		}
+	}
+
+	return json.Marshal(next)
+}
+
 // JSONObject is a wrapper type to allow either a primitive type or a JSON object.
 // In the case the value is a JSON object, OrderedMap type is used so that key order
 // is retained across Unmarshal/Marshal.
@@ -81,6 +194,20 @@ func (o *JSONObject) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// object returns a copy of the JSON object, parsing primitives that hold an escaped JSON object.
+func (o *JSONObject) object() (orderedmap.OrderedMap, error) {
+	var obj orderedmap.OrderedMap
+
+	bz, err := o.MarshalJSON()
+	if err != nil {
+		return obj, err
+	}
+	if err := obj.UnmarshalJSON(bz); err != nil {
+		return obj, err
+	}
+	return obj, nil
+}
+
 // MarshalJSON overrides the default json.Marshal behavior
 func (o JSONObject) MarshalJSON() ([]byte, error) {
 	if o.obj {
@@ -0,0 +1,160 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	"github.com/iancoleman/orderedmap"
+	"github.com/stretchr/testify/require"
+
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// The seed corpus of the fuzz tests is in testdata/fuzz. Run a fuzz test with, for example:
+//
+//	go test ./packetforward/types -run '^$' -fuzz FuzzPacketMetadata -fuzztime 1m
+
+// FuzzPacketMetadata decodes memos as packet metadata, which must not panic, and checks that the encoding of
+// decoded metadata is stable.
+func FuzzPacketMetadata(f *testing.F) {
+	f.Fuzz(func(t *testing.T, memo []byte) {
+		var packetMetadata types.PacketMetadata
+		if err := json.Unmarshal(memo, &packetMetadata); err != nil || packetMetadata.Forward == nil {
+			return
+		}
+
+		// validation and the memo of the next transfer must not panic on any metadata.
+		_ = packetMetadata.Forward.Validate()
+		_, _ = packetMetadata.Forward.NextMemo()
+
+		bz, err := json.Marshal(packetMetadata)
+		if err != nil {
+			// primitives of next or final_memo that are not valid JSON cannot be encoded again.
+			return
+		}
+		if isJSONString(t, packetMetadata.Forward.Next) || isJSONString(t, packetMetadata.Forward.FinalMemo) {
+			// strings are unescaped on every decode, see FuzzJSONObject.
+			return
+		}
+
+		// primitives are normalized by the first round trip, such as an escaped object with duplicate keys.
+		reencoded := reencodePacketMetadata(t, bz)
+		require.Equal(t, string(reencoded), string(reencodePacketMetadata(t, reencoded)))
+	})
+}
+
+// reencodePacketMetadata decodes and encodes the packet metadata.
+func reencodePacketMetadata(t *testing.T, bz []byte) []byte {
+	t.Helper()
+
+	var decoded types.PacketMetadata
+	require.NoError(t, json.Unmarshal(bz, &decoded), "re-decoding %s", bz)
+
+	reencoded, err := json.Marshal(decoded)
+	require.NoError(t, err)
+	return reencoded
+}
+
+// isJSONString returns true if the JSONObject is encoded as a JSON string.
+func isJSONString(t *testing.T, obj *types.JSONObject) bool {
+	t.Helper()
+
+	if obj == nil {
+		return false
+	}
+	bz, err := json.Marshal(obj)
+	require.NoError(t, err)
+	return len(bz) > 0 && bz[0] == '"'
+}
+
+// FuzzJSONObject decodes JSON values as a JSONObject and checks that encoding them again keeps the key order of
+// objects, and that the encoding of objects is stable.
+func FuzzJSONObject(f *testing.F) {
+	f.Fuzz(func(t *testing.T, value []byte) {
+		var obj types.JSONObject
+		if err := json.Unmarshal(value, &obj); err != nil {
+			return
+		}
+
+		bz, err := json.Marshal(obj)
+		if err != nil {
+			// primitives that are not valid JSON, such as unquoted strings, cannot be encoded again.
+			return
+		}
+
+		var expected orderedmap.OrderedMap
+		isObject := expected.UnmarshalJSON(value) == nil
+		if isObject {
+			var actual orderedmap.OrderedMap
+			require.NoError(t, actual.UnmarshalJSON(bz))
+			require.Equal(t, expected.Keys(), actual.Keys(), "key order of %s", value)
+		}
+
+		reencoded, ok := reencodeJSONObject(t, bz)
+		if isObject {
+			require.True(t, ok)
+			require.Equal(t, string(bz), string(reencoded))
+		}
+
+		// primitives are normalized by the first round trip, such as an escaped object with duplicate keys.
+		if ok {
+			stable, ok := reencodeJSONObject(t, reencoded)
+			require.True(t, ok)
+			require.Equal(t, string(reencoded), string(stable))
+		}
+	})
+}
+
+// reencodeJSONObject decodes and encodes the JSON value as a JSONObject. It returns false if the value is a
+// string, as strings are unescaped on every decode so that an escaped JSON-marshaled string becomes its value.
+func reencodeJSONObject(t *testing.T, bz []byte) ([]byte, bool) {
+	t.Helper()
+
+	if len(bz) > 0 && bz[0] == '"' {
+		return nil, false
+	}
+
+	var decoded types.JSONObject
+	require.NoError(t, json.Unmarshal(bz, &decoded), "re-decoding %s", bz)
+
+	reencoded, err := json.Marshal(decoded)
+	require.NoError(t, err)
+	return reencoded, true
+}
+
+// FuzzForwardMetadataValidate checks that forward metadata only passes validation with a receiver and valid
+// port and channel identifiers, and that valid metadata is still valid after a round trip through JSON.
+func FuzzForwardMetadataValidate(f *testing.F) {
+	f.Fuzz(func(t *testing.T, receiver, port, channel string, timeout int64, retries uint8, finalMemo []byte) {
+		metadata := types.ForwardMetadata{
+			Receiver: receiver,
+			Port:     port,
+			Channel:  channel,
+			Timeout:  types.Duration(timeout),
+			Retries:  &retries,
+		}
+		if len(finalMemo) > 0 {
+			metadata.FinalMemo = &types.JSONObject{}
+			if err := json.Unmarshal(finalMemo, metadata.FinalMemo); err != nil {
+				return
+			}
+		}
+
+		if err := metadata.Validate(); err != nil {
+			return
+		}
+		require.NotEmpty(t, metadata.Receiver)
+		require.NoError(t, host.PortIdentifierValidator(metadata.Port))
+		require.NoError(t, host.ChannelIdentifierValidator(metadata.Channel))
+
+		bz, err := json.Marshal(types.PacketMetadata{Forward: &metadata})
+		require.NoError(t, err)
+
+		var decoded types.PacketMetadata
+		require.NoError(t, json.Unmarshal(bz, &decoded), "re-decoding %s", bz)
+		require.NoError(t, decoded.Forward.Validate(), "validating %s", bz)
+		require.Equal(t, metadata.Timeout, decoded.Forward.Timeout)
+		require.Equal(t, *metadata.Retries, *decoded.Forward.Retries)
+	})
+}
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
@@ -57,3 +58,131 @@ func TestTimeoutUnmarshalJSON(t *testing.T) {
 
 	require.Equal(t, "60000000000", string(timeoutBz))
 }
+
+func TestRelayerFeeMetadataValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		relayerFee types.RelayerFeeMetadata
+		expPass    bool
+	}{
+		{"all fees", types.RelayerFeeMetadata{RecvFee: "10", AckFee: "5", TimeoutFee: "5"}, true},
+		{"recv fee only", types.RelayerFeeMetadata{RecvFee: "10"}, true},
+		{"no fees", types.RelayerFeeMetadata{}, false},
+		{"zero fees", types.RelayerFeeMetadata{RecvFee: "0", AckFee: "0", TimeoutFee: "0"}, false},
+		{"negative fee", types.RelayerFeeMetadata{RecvFee: "10", AckFee: "-5"}, false},
+		{"invalid fee", types.RelayerFeeMetadata{TimeoutFee: "5uatom"}, false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.relayerFee.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestRelayerFeeMetadataUnmarshal(t *testing.T) {
+	const memo = "{\"forward\":{\"receiver\":\"noble1f4cur2krsua2th9kkp7n0zje4stea4p9tu70u8\",\"port\":\"transfer\",\"channel\":\"channel-0\",\"relayer_fee\":{\"recv_fee\":\"10\",\"ack_fee\":\"5\",\"timeout_fee\":\"5\"}}}"
+	var packetMetadata types.PacketMetadata
+
+	err := json.Unmarshal([]byte(memo), &packetMetadata)
+	require.NoError(t, err)
+	require.NoError(t, packetMetadata.Forward.Validate())
+
+	relayerFee, err := packetMetadata.Forward.RelayerFee.RelayerFee("uatom")
+	require.NoError(t, err)
+	require.Equal(t, "20uatom", relayerFee.Total().String())
+	require.Equal(t, "5uatom", relayerFee.Unused(false).String())
+	require.Equal(t, "15uatom", relayerFee.Unused(true).String())
+}
+
+func TestForwardMetadataNextMemo(t *testing.T) {
+	tests := []struct {
+		name    string
+		memo    string
+		expMemo string
+		expErr  bool
+	}{
+		{
+			"no next or final memo",
+			`{"forward":{"receiver":"a","port":"transfer","channel":"channel-0"}}`,
+			"",
+			false,
+		},
+		{
+			"final memo on last forward",
+			`{"forward":{"receiver":"a","port":"transfer","channel":"channel-0","final_memo":{"wasm":{"contract":"c","msg":{}}}}}`,
+			`{"wasm":{"contract":"c","msg":{}}}`,
+			false,
+		},
+		{
+			"final memo passed on to next forward",
+			`{"forward":{"receiver":"a","port":"transfer","channel":"channel-0","final_memo":{"wasm":{"contract":"c"}},"next":{"forward":{"receiver":"b","port":"transfer","channel":"channel-1"}}}}`,
+			`{"forward":{"receiver":"b","port":"transfer","channel":"channel-1","final_memo":{"wasm":{"contract":"c"}}}}`,
+			false,
+		},
+		{
+			"final memo merged into next",
+			`{"forward":{"receiver":"a","port":"transfer","channel":"channel-0","final_memo":{"wasm":{"contract":"c"}},"next":{"ibc_callback":"d"}}}`,
+			`{"ibc_callback":"d","wasm":{"contract":"c"}}`,
+			false,
+		},
+		{
+			"final memo merged into escaped next",
+			`{"forward":{"receiver":"a","port":"transfer","channel":"channel-0","final_memo":"{\"wasm\":{\"contract\":\"c\"}}","next":"{\"ibc_callback\":\"d\"}"}}`,
+			`{"ibc_callback":"d","wasm":{"contract":"c"}}`,
+			false,
+		},
+		{
+			"final memo key already in next",
+			`{"forward":{"receiver":"a","port":"transfer","channel":"channel-0","final_memo":{"wasm":{"contract":"c"}},"next":{"wasm":{"contract":"d"}}}}`,
+			"",
+			true,
+		},
+		{
+			"final memo set on next forward",
+			`{"forward":{"receiver":"a","port":"transfer","channel":"channel-0","final_memo":{"wasm":{}},"next":{"forward":{"receiver":"b","port":"transfer","channel":"channel-1","final_memo":{"wasm":{}}}}}}`,
+			"",
+			true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var packetMetadata types.PacketMetadata
+			require.NoError(t, json.Unmarshal([]byte(tc.memo), &packetMetadata))
+
+			memo, err := packetMetadata.Forward.NextMemo()
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expMemo, memo)
+		})
+	}
+}
+
+func TestForwardMetadataValidateFinalMemo(t *testing.T) {
+	metadata := &types.ForwardMetadata{Receiver: "a", Port: "transfer", Channel: "channel-0"}
+
+	metadata.FinalMemo = &types.JSONObject{}
+	require.NoError(t, json.Unmarshal([]byte(`{"wasm":{}}`), metadata.FinalMemo))
+	require.NoError(t, metadata.Validate())
+
+	metadata.FinalMemo = &types.JSONObject{}
+	require.NoError(t, json.Unmarshal([]byte(`"not an object"`), metadata.FinalMemo))
+	require.Error(t, metadata.Validate())
+
+	metadata.FinalMemo = &types.JSONObject{}
+	largeMemo, err := json.Marshal(map[string]string{"data": strings.Repeat("a", types.MaxFinalMemoLength)})
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(largeMemo, metadata.FinalMemo))
+	require.ErrorContains(t, metadata.Validate(), "final memo length")
+}
@@ -1,7 +1,7 @@
 package types
 
 // NewGenesisState creates a pfm GenesisState instance.
-func NewGenesisState(params Params, inFlightPackets map[string]InFlightPacket) *GenesisState {
+func NewGenesisState(params Params, inFlightPackets []InFlightPacketEntry) *GenesisState {
 	return &GenesisState{
 		Params:          params,
 		InFlightPackets: inFlightPackets,
@@ -11,12 +11,31 @@ func NewGenesisState(params Params, inFlightPackets map[string]InFlightPacket) *
 // DefaultGenesisState returns a GenesisState with a default fee percentage of 0.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:          DefaultParams(),
-		InFlightPackets: make(map[string]InFlightPacket),
+		Params: DefaultParams(),
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if err := ValidateInFlightPacketEntries(gs.InFlightPackets); err != nil {
+		return err
+	}
+
+	if err := gs.PauseState.Validate(); err != nil {
+		return err
+	}
+
+	if err := ValidateRoutes(gs.Routes); err != nil {
+		return err
+	}
+
+	if err := ValidateReceipts(gs.Receipts); err != nil {
+		return err
+	}
+
+	return ValidatePendingRefunds(gs.PendingRefunds)
 }
@@ -6,17 +6,22 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -24,14 +29,62 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ForwardOutcome is how a forward settled.
+type ForwardOutcome int32
+
+const (
+	// FORWARD_OUTCOME_UNSPECIFIED is the default value and is not a valid
+	// outcome.
+	ForwardOutcomeUnspecified ForwardOutcome = 0
+	// FORWARD_OUTCOME_SUCCESS is a forward that was acknowledged successfully.
+	ForwardOutcomeSuccess ForwardOutcome = 1
+	// FORWARD_OUTCOME_REFUNDED is a forward that failed and was refunded to the
+	// chain the original packet was received from.
+	ForwardOutcomeRefunded ForwardOutcome = 2
+	// FORWARD_OUTCOME_RECOVERED is a non-refundable forward that failed and was
+	// moved to an account the original sender can recover it from.
+	ForwardOutcomeRecovered ForwardOutcome = 3
+)
+
+var ForwardOutcome_name = map[int32]string{
+	0: "FORWARD_OUTCOME_UNSPECIFIED",
+	1: "FORWARD_OUTCOME_SUCCESS",
+	2: "FORWARD_OUTCOME_REFUNDED",
+	3: "FORWARD_OUTCOME_RECOVERED",
+}
+
+var ForwardOutcome_value = map[string]int32{
+	"FORWARD_OUTCOME_UNSPECIFIED": 0,
+	"FORWARD_OUTCOME_SUCCESS":     1,
+	"FORWARD_OUTCOME_REFUNDED":    2,
+	"FORWARD_OUTCOME_RECOVERED":   3,
+}
+
+func (x ForwardOutcome) String() string {
+	return proto.EnumName(ForwardOutcome_name, int32(x))
+}
+
+func (ForwardOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_afd4e56ea31af982, []int{0}
+}
+
 // GenesisState defines the packetforward genesis state
 type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	// key - information about forwarded packet: src_channel
-	// (parsedReceiver.Channel), src_port (parsedReceiver.Port), sequence value -
-	// information about original packet for refunding if necessary: retries,
-	// srcPacketSender, srcPacket.DestinationChannel, srcPacket.DestinationPort
-	InFlightPackets map[string]InFlightPacket `protobuf:"bytes,2,rep,name=in_flight_packets,json=inFlightPackets,proto3" json:"in_flight_packets" yaml:"in_flight_packets" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// in_flight_packets are the forwarded packets that have not been
+	// acknowledged or timed out yet, with the information about their original
+	// packets for refunding if necessary. They are sorted by the port, channel
+	// and sequence of the forwarded packet.
+	InFlightPackets []InFlightPacketEntry `protobuf:"bytes,7,rep,name=in_flight_packets,json=inFlightPackets,proto3" json:"in_flight_packets" yaml:"in_flight_packets"`
+	// pause_state defines which forwards are currently halted.
+	PauseState PauseState `protobuf:"bytes,3,opt,name=pause_state,json=pauseState,proto3" json:"pause_state"`
+	// routes are the registered routes, sorted by name.
+	Routes []Route `protobuf:"bytes,4,rep,name=routes,proto3" json:"routes"`
+	// receipts are the retained forward receipts, sorted by original packet.
+	Receipts []ForwardReceipt `protobuf:"bytes,5,rep,name=receipts,proto3" json:"receipts"`
+	// pending_refunds are the refund packets that have not been acknowledged
+	// yet, sorted by refund packet.
+	PendingRefunds []PendingRefund `protobuf:"bytes,6,rep,name=pending_refunds,json=pendingRefunds,proto3" json:"pending_refunds"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -74,16 +127,91 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
-func (m *GenesisState) GetInFlightPackets() map[string]InFlightPacket {
+func (m *GenesisState) GetInFlightPackets() []InFlightPacketEntry {
 	if m != nil {
 		return m.InFlightPackets
 	}
 	return nil
 }
 
+func (m *GenesisState) GetPauseState() PauseState {
+	if m != nil {
+		return m.PauseState
+	}
+	return PauseState{}
+}
+
+func (m *GenesisState) GetRoutes() []Route {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReceipts() []ForwardReceipt {
+	if m != nil {
+		return m.Receipts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingRefunds() []PendingRefund {
+	if m != nil {
+		return m.PendingRefunds
+	}
+	return nil
+}
+
 // Params defines the set of packetforward parameters.
 type Params struct {
 	FeePercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=fee_percentage,json=feePercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_percentage" yaml:"fee_percentage"`
+	// memo_byte_gas is the gas consumed per byte of the memo of a forwarded
+	// packet.
+	MemoByteGas uint64 `protobuf:"varint,2,opt,name=memo_byte_gas,json=memoByteGas,proto3" json:"memo_byte_gas,omitempty" yaml:"memo_byte_gas"`
+	// max_forward_gas is the maximum gas a single forward may consume. A forward
+	// that exceeds it is refunded with an error acknowledgement. Zero disables
+	// the limit.
+	MaxForwardGas uint64 `protobuf:"varint,3,opt,name=max_forward_gas,json=maxForwardGas,proto3" json:"max_forward_gas,omitempty" yaml:"max_forward_gas"`
+	// allowed_denoms are the only denoms, as denominated on this chain, that may
+	// be forwarded. An empty list allows every denom.
+	AllowedDenoms []string `protobuf:"bytes,4,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty" yaml:"allowed_denoms"`
+	// blocked_denoms are the denoms, as denominated on this chain, that may not
+	// be forwarded.
+	BlockedDenoms []string `protobuf:"bytes,5,rep,name=blocked_denoms,json=blockedDenoms,proto3" json:"blocked_denoms,omitempty" yaml:"blocked_denoms"`
+	// min_forward_amounts are the minimum amounts of the denoms, as denominated
+	// on this chain, that may be forwarded. Denoms without a minimum may be
+	// forwarded in any amount.
+	MinForwardAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=min_forward_amounts,json=minForwardAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_forward_amounts" yaml:"min_forward_amounts"`
+	// receipt_retention_blocks is the number of blocks a forward receipt is
+	// retained for after the forward settled. Zero disables forward receipts.
+	ReceiptRetentionBlocks uint64 `protobuf:"varint,7,opt,name=receipt_retention_blocks,json=receiptRetentionBlocks,proto3" json:"receipt_retention_blocks,omitempty" yaml:"receipt_retention_blocks"`
+	// channel_equivalences are groups of channels that lead to the same
+	// counterparty chain. A forward over one channel of a group fails over to
+	// the other channels of the group when it cannot be sent or times out.
+	ChannelEquivalences []ChannelEquivalence `protobuf:"bytes,8,rep,name=channel_equivalences,json=channelEquivalences,proto3" json:"channel_equivalences" yaml:"channel_equivalences"`
+	// default_retries is the number of times a timed out forward is retried if
+	// the memo does not set retries.
+	DefaultRetries uint32 `protobuf:"varint,9,opt,name=default_retries,json=defaultRetries,proto3" json:"default_retries,omitempty" yaml:"default_retries"`
+	// max_retries is the maximum number of retries of a forward. Larger retries
+	// set in the memo are clamped to it.
+	MaxRetries uint32 `protobuf:"varint,10,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty" yaml:"max_retries"`
+	// default_forward_timeout is the timeout of a forward if the memo does not
+	// set a timeout.
+	DefaultForwardTimeout time.Duration `protobuf:"bytes,11,opt,name=default_forward_timeout,json=defaultForwardTimeout,proto3,stdduration" json:"default_forward_timeout" yaml:"default_forward_timeout"`
+	// min_forward_timeout is the minimum timeout of a forward. Shorter timeouts
+	// set in the memo are clamped to it.
+	MinForwardTimeout time.Duration `protobuf:"bytes,12,opt,name=min_forward_timeout,json=minForwardTimeout,proto3,stdduration" json:"min_forward_timeout" yaml:"min_forward_timeout"`
+	// max_forward_timeout is the maximum timeout of a forward. Longer timeouts
+	// set in the memo are clamped to it.
+	MaxForwardTimeout time.Duration `protobuf:"bytes,13,opt,name=max_forward_timeout,json=maxForwardTimeout,proto3,stdduration" json:"max_forward_timeout" yaml:"max_forward_timeout"`
+	// refund_timeout is the timeout of packets that refund tokens held by this
+	// chain.
+	RefundTimeout time.Duration `protobuf:"bytes,14,opt,name=refund_timeout,json=refundTimeout,proto3,stdduration" json:"refund_timeout" yaml:"refund_timeout"`
+	// fee_summary_acks replaces the result of successful acknowledgements of
+	// forwards that were charged fees with a JSON fee summary. It changes the
+	// acknowledgement format expected by the source chain, so it is disabled by
+	// default.
+	FeeSummaryAcks bool `protobuf:"varint,15,opt,name=fee_summary_acks,json=feeSummaryAcks,proto3" json:"fee_summary_acks,omitempty" yaml:"fee_summary_acks"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -119,6 +247,160 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMemoByteGas() uint64 {
+	if m != nil {
+		return m.MemoByteGas
+	}
+	return 0
+}
+
+func (m *Params) GetMaxForwardGas() uint64 {
+	if m != nil {
+		return m.MaxForwardGas
+	}
+	return 0
+}
+
+func (m *Params) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetBlockedDenoms() []string {
+	if m != nil {
+		return m.BlockedDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetMinForwardAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinForwardAmounts
+	}
+	return nil
+}
+
+func (m *Params) GetReceiptRetentionBlocks() uint64 {
+	if m != nil {
+		return m.ReceiptRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetChannelEquivalences() []ChannelEquivalence {
+	if m != nil {
+		return m.ChannelEquivalences
+	}
+	return nil
+}
+
+func (m *Params) GetDefaultRetries() uint32 {
+	if m != nil {
+		return m.DefaultRetries
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRetries() uint32 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
+}
+
+func (m *Params) GetDefaultForwardTimeout() time.Duration {
+	if m != nil {
+		return m.DefaultForwardTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetMinForwardTimeout() time.Duration {
+	if m != nil {
+		return m.MinForwardTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetMaxForwardTimeout() time.Duration {
+	if m != nil {
+		return m.MaxForwardTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetRefundTimeout() time.Duration {
+	if m != nil {
+		return m.RefundTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetFeeSummaryAcks() bool {
+	if m != nil {
+		return m.FeeSummaryAcks
+	}
+	return false
+}
+
+// ChannelEquivalence is a group of channels on a port that lead to the same
+// counterparty chain, in order of preference.
+type ChannelEquivalence struct {
+	// port_id is the port of the channels.
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_ids are the equivalent channels.
+	ChannelIds []string `protobuf:"bytes,2,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
+}
+
+func (m *ChannelEquivalence) Reset()         { *m = ChannelEquivalence{} }
+func (m *ChannelEquivalence) String() string { return proto.CompactTextString(m) }
+func (*ChannelEquivalence) ProtoMessage()    {}
+func (*ChannelEquivalence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd4e56ea31af982, []int{2}
+}
+func (m *ChannelEquivalence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelEquivalence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelEquivalence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelEquivalence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelEquivalence.Merge(m, src)
+}
+func (m *ChannelEquivalence) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelEquivalence) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelEquivalence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelEquivalence proto.InternalMessageInfo
+
+func (m *ChannelEquivalence) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ChannelEquivalence) GetChannelIds() []string {
+	if m != nil {
+		return m.ChannelIds
+	}
+	return nil
+}
+
 // InFlightPacket contains information about original packet for
 // writing the acknowledgement and refunding if necessary.
 type InFlightPacket struct {
@@ -134,13 +416,35 @@ type InFlightPacket struct {
 	RetriesRemaining       int32  `protobuf:"varint,10,opt,name=retries_remaining,json=retriesRemaining,proto3" json:"retries_remaining,omitempty"`
 	Timeout                uint64 `protobuf:"varint,11,opt,name=timeout,proto3" json:"timeout,omitempty"`
 	Nonrefundable          bool   `protobuf:"varint,12,opt,name=nonrefundable,proto3" json:"nonrefundable,omitempty"`
+	// forward_token is the token sent in the forwarded packet.
+	ForwardToken types.Coin `protobuf:"bytes,13,opt,name=forward_token,json=forwardToken,proto3" json:"forward_token"`
+	// resolved is set once the original packet has been acknowledged because
+	// the forward channel was closed. The entry is kept so that a late timeout
+	// of the forwarded packet is not refunded a second time.
+	Resolved bool `protobuf:"varint,14,opt,name=resolved,proto3" json:"resolved,omitempty"`
+	// relayer_fee is the ICS-29 relayer fee escrowed for the forwarded packet.
+	RelayerFee *RelayerFee `protobuf:"bytes,15,opt,name=relayer_fee,json=relayerFee,proto3" json:"relayer_fee,omitempty"`
+	// fees are the fees paid to the community pool for the forward, including
+	// its retries.
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,16,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+	// retriable_error_codes are the ABCI codes of error acknowledgements of the
+	// forwarded packet that are retried like timeouts. Acknowledgements do not
+	// carry the codespace of the error, so a code matches the errors of every
+	// module of the next chain that use it.
+	RetriableErrorCodes []uint32 `protobuf:"varint,17,rep,packed,name=retriable_error_codes,json=retriableErrorCodes,proto3" json:"retriable_error_codes,omitempty"`
+	// alternate_channel_id is the channel retries of the forward are sent over
+	// instead of the channel of the forward.
+	AlternateChannelId string `protobuf:"bytes,18,opt,name=alternate_channel_id,json=alternateChannelId,proto3" json:"alternate_channel_id,omitempty"`
+	// attempted_channel_ids are the channels the forward was attempted over,
+	// including its retries and failovers, in order.
+	AttemptedChannelIds []string `protobuf:"bytes,19,rep,name=attempted_channel_ids,json=attemptedChannelIds,proto3" json:"attempted_channel_ids,omitempty"`
 }
 
 func (m *InFlightPacket) Reset()         { *m = InFlightPacket{} }
 func (m *InFlightPacket) String() string { return proto.CompactTextString(m) }
 func (*InFlightPacket) ProtoMessage()    {}
 func (*InFlightPacket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_afd4e56ea31af982, []int{2}
+	return fileDescriptor_afd4e56ea31af982, []int{3}
 }
 func (m *InFlightPacket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -253,353 +557,4378 @@ func (m *InFlightPacket) GetNonrefundable() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*GenesisState)(nil), "packetforward.v1.GenesisState")
-	proto.RegisterMapType((map[string]InFlightPacket)(nil), "packetforward.v1.GenesisState.InFlightPacketsEntry")
-	proto.RegisterType((*Params)(nil), "packetforward.v1.Params")
-	proto.RegisterType((*InFlightPacket)(nil), "packetforward.v1.InFlightPacket")
+func (m *InFlightPacket) GetForwardToken() types.Coin {
+	if m != nil {
+		return m.ForwardToken
+	}
+	return types.Coin{}
 }
 
-func init() { proto.RegisterFile("packetforward/v1/genesis.proto", fileDescriptor_afd4e56ea31af982) }
-
-var fileDescriptor_afd4e56ea31af982 = []byte{
-	// 645 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xcd, 0x4e, 0x1b, 0x3b,
-	0x14, 0xce, 0x84, 0x10, 0xc0, 0x09, 0x7f, 0xbe, 0x70, 0xaf, 0xc5, 0x22, 0x19, 0x45, 0xe8, 0x36,
-	0x2a, 0x4a, 0x46, 0x80, 0x44, 0x11, 0xbb, 0xa6, 0xb4, 0x94, 0x5d, 0x34, 0x41, 0x5d, 0x74, 0x33,
-	0x72, 0x66, 0x4e, 0x26, 0x16, 0x19, 0xcf, 0xd4, 0x76, 0x42, 0xb3, 0xec, 0x1b, 0xf4, 0x0d, 0xfa,
-	0x0a, 0x7d, 0x0c, 0x96, 0x2c, 0xab, 0x2e, 0xa2, 0x0a, 0xde, 0x80, 0x27, 0xa8, 0x62, 0x4f, 0x20,
-	0xd3, 0x74, 0x35, 0xf6, 0xf9, 0x7e, 0x7c, 0xce, 0x27, 0x8f, 0x51, 0x25, 0xa1, 0xfe, 0x35, 0xa8,
-	0x5e, 0x2c, 0x6e, 0xa8, 0x08, 0x9c, 0xd1, 0xa1, 0x13, 0x02, 0x07, 0xc9, 0x64, 0x33, 0x11, 0xb1,
-	0x8a, 0xf1, 0x56, 0x06, 0x6f, 0x8e, 0x0e, 0xf7, 0x76, 0xc2, 0x38, 0x8c, 0x35, 0xe8, 0x4c, 0x57,
-	0x86, 0x57, 0xfb, 0x9e, 0x47, 0xe5, 0x0b, 0xa3, 0xec, 0x28, 0xaa, 0x00, 0x9f, 0xa0, 0x62, 0x42,
-	0x05, 0x8d, 0x24, 0xb1, 0x6c, 0xab, 0x5e, 0x3a, 0x22, 0xcd, 0x3f, 0x9d, 0x9a, 0x6d, 0x8d, 0xb7,
-	0x0a, 0xb7, 0x93, 0x6a, 0xce, 0x4d, 0xd9, 0xf8, 0x8b, 0x85, 0xb6, 0x19, 0xf7, 0x7a, 0x03, 0x16,
-	0xf6, 0x95, 0x67, 0x34, 0x92, 0xe4, 0xed, 0xa5, 0x7a, 0xe9, 0xe8, 0x78, 0xd1, 0x63, 0xfe, 0xcc,
-	0xe6, 0x25, 0x7f, 0xa7, 0x65, 0x6d, 0xa3, 0x7a, 0xcb, 0x95, 0x18, 0xb7, 0xec, 0xa9, 0xfd, 0xe3,
-	0xa4, 0x4a, 0xc6, 0x34, 0x1a, 0x9c, 0xd5, 0x16, 0xbc, 0x6b, 0xee, 0x26, 0xcb, 0xea, 0xf6, 0x02,
-	0xb4, 0xf3, 0x37, 0x2b, 0xbc, 0x85, 0x96, 0xae, 0x61, 0xac, 0x07, 0x5a, 0x73, 0xa7, 0x4b, 0x7c,
-	0x82, 0x96, 0x47, 0x74, 0x30, 0x04, 0x92, 0xd7, 0x43, 0xda, 0x8b, 0x0d, 0x66, 0x8d, 0x5c, 0x43,
-	0x3f, 0xcb, 0x9f, 0x5a, 0xb5, 0xcf, 0xa8, 0x68, 0x12, 0xc0, 0x1c, 0x6d, 0xf4, 0x00, 0xbc, 0x04,
-	0x84, 0x0f, 0x5c, 0xd1, 0x10, 0xcc, 0x11, 0xad, 0x8b, 0x69, 0xeb, 0x3f, 0x27, 0xd5, 0xff, 0x43,
-	0xa6, 0xfa, 0xc3, 0x6e, 0xd3, 0x8f, 0x23, 0xc7, 0x8f, 0x65, 0x14, 0xcb, 0xf4, 0xd3, 0x90, 0xc1,
-	0xb5, 0xa3, 0xc6, 0x09, 0xc8, 0xe6, 0x39, 0xf8, 0x8f, 0x93, 0xea, 0xae, 0x19, 0x32, 0xeb, 0x56,
-	0x73, 0xd7, 0x7b, 0x00, 0xed, 0xe7, 0xfd, 0xb7, 0x02, 0xda, 0xc8, 0xf6, 0x85, 0x4f, 0xd0, 0x7f,
-	0xb1, 0x60, 0x21, 0xe3, 0x74, 0xe0, 0x49, 0xe0, 0x01, 0x08, 0x8f, 0x06, 0x81, 0x00, 0x29, 0xd3,
-	0x71, 0x77, 0x67, 0x70, 0x47, 0xa3, 0xaf, 0x0d, 0x88, 0x5f, 0xa2, 0x6d, 0x01, 0xbd, 0x21, 0x0f,
-	0x3c, 0xbf, 0x4f, 0x39, 0x87, 0x81, 0xc7, 0x02, 0x1d, 0xc6, 0x9a, 0xbb, 0x69, 0x80, 0x37, 0xa6,
-	0x7e, 0x19, 0xe0, 0x7d, 0xb4, 0x91, 0x72, 0x93, 0x58, 0xa8, 0x29, 0x71, 0x49, 0x13, 0xcb, 0xa6,
-	0xda, 0x8e, 0x85, 0xba, 0x0c, 0xf0, 0x21, 0xda, 0x35, 0x21, 0x7a, 0x52, 0xf8, 0xf3, 0xae, 0x05,
-	0x4d, 0xc6, 0x06, 0xec, 0x08, 0xff, 0xd9, 0xf8, 0x00, 0xe1, 0x39, 0xc9, 0xcc, 0x7c, 0xd9, 0x74,
-	0xf1, 0xc4, 0x4f, 0xfd, 0x4f, 0x11, 0x49, 0xc9, 0x8a, 0x45, 0x10, 0x0f, 0xcd, 0x57, 0x2a, 0x1a,
-	0x25, 0xa4, 0x68, 0x5b, 0xf5, 0x82, 0xfb, 0xaf, 0xc1, 0xaf, 0x0c, 0x7c, 0x35, 0x43, 0xf1, 0xd1,
-	0x53, 0x67, 0x33, 0x65, 0x1f, 0xa6, 0x11, 0x92, 0x15, 0x7d, 0xd2, 0x3f, 0x19, 0xd9, 0x7b, 0x0d,
-	0xe1, 0x2a, 0x2a, 0xa5, 0x9a, 0x80, 0x2a, 0x4a, 0x56, 0x6d, 0xab, 0x5e, 0x76, 0x91, 0x29, 0x9d,
-	0x53, 0x45, 0xf1, 0x0b, 0x94, 0xe6, 0xe4, 0x49, 0xf8, 0x34, 0x04, 0xee, 0x03, 0x59, 0xd3, 0x5d,
-	0xa4, 0x59, 0x75, 0xd2, 0x2a, 0x3e, 0x98, 0x26, 0xad, 0x04, 0x03, 0xe9, 0x09, 0x88, 0x28, 0xe3,
-	0x8c, 0x87, 0x04, 0xd9, 0x56, 0x7d, 0xd9, 0xdd, 0x4a, 0x01, 0x77, 0x56, 0xc7, 0x04, 0xad, 0xa4,
-	0x3d, 0x92, 0x92, 0x76, 0x9b, 0x6d, 0xf1, 0x3e, 0x5a, 0xe7, 0x31, 0x37, 0xde, 0xb4, 0x3b, 0x00,
-	0x52, 0xb6, 0xad, 0xfa, 0xaa, 0x9b, 0x2d, 0xb6, 0x92, 0xdb, 0xfb, 0x8a, 0x75, 0x77, 0x5f, 0xb1,
-	0x7e, 0xdd, 0x57, 0xac, 0xaf, 0x0f, 0x95, 0xdc, 0xdd, 0x43, 0x25, 0xf7, 0xe3, 0xa1, 0x92, 0xfb,
-	0xf8, 0x61, 0xf1, 0x2e, 0xb2, 0xae, 0xdf, 0xa0, 0x49, 0x22, 0x9d, 0x88, 0x05, 0xc1, 0x00, 0x6e,
-	0xa8, 0x00, 0xc7, 0x4c, 0xd8, 0x48, 0x7f, 0x84, 0xc6, 0x1c, 0x32, 0x7a, 0xe5, 0x64, 0xdf, 0x1c,
-	0x7d, 0x7f, 0xbb, 0x45, 0xfd, 0x8e, 0x1c, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xa0, 0x23, 0x75,
-	0x84, 0x91, 0x04, 0x00, 0x00,
+func (m *InFlightPacket) GetResolved() bool {
+	if m != nil {
+		return m.Resolved
+	}
+	return false
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *InFlightPacket) GetRelayerFee() *RelayerFee {
+	if m != nil {
+		return m.RelayerFee
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *InFlightPacket) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.InFlightPackets) > 0 {
-		for k := range m.InFlightPackets {
-			v := m.InFlightPackets[k]
-			baseI := i
-			{
-				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *InFlightPacket) GetRetriableErrorCodes() []uint32 {
+	if m != nil {
+		return m.RetriableErrorCodes
 	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *InFlightPacket) GetAlternateChannelId() string {
+	if m != nil {
+		return m.AlternateChannelId
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *InFlightPacket) GetAttemptedChannelIds() []string {
+	if m != nil {
+		return m.AttemptedChannelIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// InFlightPacketEntry is an in-flight packet together with the identifiers of
+// the forwarded packet it is stored under.
+type InFlightPacketEntry struct {
+	// channel_id is the channel the packet was forwarded over.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// port_id is the port the packet was forwarded from.
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// sequence is the sequence of the forwarded packet.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// in_flight_packet contains information about the original packet.
+	InFlightPacket InFlightPacket `protobuf:"bytes,4,opt,name=in_flight_packet,json=inFlightPacket,proto3" json:"in_flight_packet"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.FeePercentage.Size()
-		i -= size
-		if _, err := m.FeePercentage.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *InFlightPacketEntry) Reset()         { *m = InFlightPacketEntry{} }
+func (m *InFlightPacketEntry) String() string { return proto.CompactTextString(m) }
+func (*InFlightPacketEntry) ProtoMessage()    {}
+func (*InFlightPacketEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd4e56ea31af982, []int{4}
+}
+func (m *InFlightPacketEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InFlightPacketEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InFlightPacketEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *InFlightPacketEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InFlightPacketEntry.Merge(m, src)
+}
+func (m *InFlightPacketEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *InFlightPacketEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_InFlightPacketEntry.DiscardUnknown(m)
 }
 
-func (m *InFlightPacket) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_InFlightPacketEntry proto.InternalMessageInfo
+
+func (m *InFlightPacketEntry) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *InFlightPacket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *InFlightPacketEntry) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
 }
 
-func (m *InFlightPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonrefundable {
-		i--
-		if m.Nonrefundable {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.Timeout != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Timeout))
-		i--
-		dAtA[i] = 0x58
+func (m *InFlightPacketEntry) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	if m.RetriesRemaining != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.RetriesRemaining))
-		i--
-		dAtA[i] = 0x50
+	return 0
+}
+
+func (m *InFlightPacketEntry) GetInFlightPacket() InFlightPacket {
+	if m != nil {
+		return m.InFlightPacket
 	}
-	if m.RefundSequence != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.RefundSequence))
-		i--
-		dAtA[i] = 0x48
+	return InFlightPacket{}
+}
+
+// RelayerFee defines the ICS-29 fees paid to relayers of a forwarded packet.
+type RelayerFee struct {
+	// recv_fee is paid to the relayer of the forwarded packet.
+	RecvFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=recv_fee,json=recvFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"recv_fee"`
+	// ack_fee is paid to the relayer of the acknowledgement.
+	AckFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=ack_fee,json=ackFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"ack_fee"`
+	// timeout_fee is paid to the relayer of the timeout.
+	TimeoutFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=timeout_fee,json=timeoutFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"timeout_fee"`
+}
+
+func (m *RelayerFee) Reset()         { *m = RelayerFee{} }
+func (m *RelayerFee) String() string { return proto.CompactTextString(m) }
+func (*RelayerFee) ProtoMessage()    {}
+func (*RelayerFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd4e56ea31af982, []int{5}
+}
+func (m *RelayerFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayerFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayerFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.PacketData) > 0 {
-		i -= len(m.PacketData)
-		copy(dAtA[i:], m.PacketData)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PacketData)))
-		i--
-		dAtA[i] = 0x42
+}
+func (m *RelayerFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayerFee.Merge(m, src)
+}
+func (m *RelayerFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayerFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayerFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayerFee proto.InternalMessageInfo
+
+func (m *RelayerFee) GetRecvFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RecvFee
 	}
-	if len(m.PacketTimeoutHeight) > 0 {
-		i -= len(m.PacketTimeoutHeight)
-		copy(dAtA[i:], m.PacketTimeoutHeight)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PacketTimeoutHeight)))
-		i--
-		dAtA[i] = 0x3a
+	return nil
+}
+
+func (m *RelayerFee) GetAckFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AckFee
 	}
-	if m.PacketTimeoutTimestamp != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.PacketTimeoutTimestamp))
-		i--
-		dAtA[i] = 0x30
+	return nil
+}
+
+func (m *RelayerFee) GetTimeoutFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TimeoutFee
 	}
-	if len(m.PacketSrcPortId) > 0 {
-		i -= len(m.PacketSrcPortId)
-		copy(dAtA[i:], m.PacketSrcPortId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PacketSrcPortId)))
-		i--
-		dAtA[i] = 0x2a
+	return nil
+}
+
+// PauseState defines which new forwards are halted. Packets that are already
+// in flight are not affected and settle normally.
+type PauseState struct {
+	// global halts all new forwards when set.
+	Global bool `protobuf:"varint,1,opt,name=global,proto3" json:"global,omitempty"`
+	// channel_ids halts new forwards received on, or sent over, any of these
+	// channels.
+	ChannelIds []string `protobuf:"bytes,2,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty" yaml:"channel_ids"`
+	// denoms halts new forwards of any of these denoms, as denominated on this
+	// chain.
+	Denoms []string `protobuf:"bytes,3,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *PauseState) Reset()         { *m = PauseState{} }
+func (m *PauseState) String() string { return proto.CompactTextString(m) }
+func (*PauseState) ProtoMessage()    {}
+func (*PauseState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd4e56ea31af982, []int{6}
+}
+func (m *PauseState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.PacketSrcChannelId) > 0 {
-		i -= len(m.PacketSrcChannelId)
-		copy(dAtA[i:], m.PacketSrcChannelId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PacketSrcChannelId)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *PauseState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseState.Merge(m, src)
+}
+func (m *PauseState) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseState) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseState proto.InternalMessageInfo
+
+func (m *PauseState) GetGlobal() bool {
+	if m != nil {
+		return m.Global
 	}
-	if len(m.RefundPortId) > 0 {
-		i -= len(m.RefundPortId)
-		copy(dAtA[i:], m.RefundPortId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RefundPortId)))
-		i--
-		dAtA[i] = 0x1a
+	return false
+}
+
+func (m *PauseState) GetChannelIds() []string {
+	if m != nil {
+		return m.ChannelIds
 	}
-	if len(m.RefundChannelId) > 0 {
-		i -= len(m.RefundChannelId)
-		copy(dAtA[i:], m.RefundChannelId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RefundChannelId)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *PauseState) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
 	}
-	if len(m.OriginalSenderAddress) > 0 {
-		i -= len(m.OriginalSenderAddress)
-		copy(dAtA[i:], m.OriginalSenderAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.OriginalSenderAddress)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+// Route is a named path of hops that a forward memo can refer to instead of
+// spelling out the channel of every hop.
+type Route struct {
+	// name identifies the route in the forward memo.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// hops are the ports and channels to forward over, starting on this chain.
+	Hops []RouteHop `protobuf:"bytes,2,rep,name=hops,proto3" json:"hops"`
+}
+
+func (m *Route) Reset()         { *m = Route{} }
+func (m *Route) String() string { return proto.CompactTextString(m) }
+func (*Route) ProtoMessage()    {}
+func (*Route) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd4e56ea31af982, []int{7}
+}
+func (m *Route) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Route) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Route.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *Route) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Route.Merge(m, src)
+}
+func (m *Route) XXX_Size() int {
+	return m.Size()
+}
+func (m *Route) XXX_DiscardUnknown() {
+	xxx_messageInfo_Route.DiscardUnknown(m)
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_Route proto.InternalMessageInfo
+
+func (m *Route) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Route) GetHops() []RouteHop {
+	if m != nil {
+		return m.Hops
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.InFlightPackets) > 0 {
-		for k, v := range m.InFlightPackets {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenesis(uint64(len(k))) + 1 + l + sovGenesis(uint64(l))
-			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
+	return nil
+}
+
+// RouteHop is a single forward of a route.
+type RouteHop struct {
+	// port_id is the port to forward from.
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id is the channel to forward over.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *RouteHop) Reset()         { *m = RouteHop{} }
+func (m *RouteHop) String() string { return proto.CompactTextString(m) }
+func (*RouteHop) ProtoMessage()    {}
+func (*RouteHop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd4e56ea31af982, []int{8}
+}
+func (m *RouteHop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouteHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouteHop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *RouteHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteHop.Merge(m, src)
+}
+func (m *RouteHop) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouteHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteHop.DiscardUnknown(m)
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_RouteHop proto.InternalMessageInfo
+
+func (m *RouteHop) GetPortId() string {
+	if m != nil {
+		return m.PortId
 	}
-	var l int
-	_ = l
-	l = m.FeePercentage.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	return ""
 }
 
-func (m *InFlightPacket) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RouteHop) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	var l int
-	_ = l
-	l = len(m.OriginalSenderAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return ""
+}
+
+// ForwardReceipt records how a forward settled once the original packet was
+// acknowledged.
+type ForwardReceipt struct {
+	// original_sender is the sender of the original packet on the source chain.
+	OriginalSender string `protobuf:"bytes,1,opt,name=original_sender,json=originalSender,proto3" json:"original_sender,omitempty"`
+	// port_id is the port the original packet was received on.
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id is the channel the original packet was received on.
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence is the sequence of the original packet.
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// forward_port_id is the port the last attempt of the forward was sent from.
+	ForwardPortId string `protobuf:"bytes,5,opt,name=forward_port_id,json=forwardPortId,proto3" json:"forward_port_id,omitempty"`
+	// forward_channel_id is the channel the last attempt of the forward was sent
+	// over.
+	ForwardChannelId string `protobuf:"bytes,6,opt,name=forward_channel_id,json=forwardChannelId,proto3" json:"forward_channel_id,omitempty"`
+	// forward_sequence is the sequence of the last attempt of the forward.
+	ForwardSequence uint64 `protobuf:"varint,7,opt,name=forward_sequence,json=forwardSequence,proto3" json:"forward_sequence,omitempty"`
+	// outcome is how the forward settled.
+	Outcome ForwardOutcome `protobuf:"varint,8,opt,name=outcome,proto3,enum=packetforward.v1.ForwardOutcome" json:"outcome,omitempty"`
+	// token is the token received in the original packet, as denominated on this
+	// chain.
+	Token types.Coin `protobuf:"bytes,9,opt,name=token,proto3" json:"token"`
+	// forward_token is the token sent in the last attempt of the forward.
+	ForwardToken types.Coin `protobuf:"bytes,10,opt,name=forward_token,json=forwardToken,proto3" json:"forward_token"`
+	// fees are the fees paid to the community pool for the forward.
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+	// relayer_fee is the ICS-29 relayer fee escrowed for the last attempt of the
+	// forward.
+	RelayerFee *RelayerFee `protobuf:"bytes,12,opt,name=relayer_fee,json=relayerFee,proto3" json:"relayer_fee,omitempty"`
+	// error is the error of the acknowledgement of a forward that failed.
+	Error string `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
+	// recovery_address is the account a recovered forward was moved to.
+	RecoveryAddress string `protobuf:"bytes,14,opt,name=recovery_address,json=recoveryAddress,proto3" json:"recovery_address,omitempty"`
+	// height is the block height the forward settled at.
+	Height int64 `protobuf:"varint,15,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ForwardReceipt) Reset()         { *m = ForwardReceipt{} }
+func (m *ForwardReceipt) String() string { return proto.CompactTextString(m) }
+func (*ForwardReceipt) ProtoMessage()    {}
+func (*ForwardReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd4e56ea31af982, []int{9}
+}
+func (m *ForwardReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.RefundChannelId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+}
+func (m *ForwardReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardReceipt.Merge(m, src)
+}
+func (m *ForwardReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardReceipt proto.InternalMessageInfo
+
+func (m *ForwardReceipt) GetOriginalSender() string {
+	if m != nil {
+		return m.OriginalSender
 	}
-	l = len(m.RefundPortId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return ""
+}
+
+func (m *ForwardReceipt) GetPortId() string {
+	if m != nil {
+		return m.PortId
 	}
-	l = len(m.PacketSrcChannelId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return ""
+}
+
+func (m *ForwardReceipt) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	l = len(m.PacketSrcPortId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return ""
+}
+
+func (m *ForwardReceipt) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	if m.PacketTimeoutTimestamp != 0 {
-		n += 1 + sovGenesis(uint64(m.PacketTimeoutTimestamp))
+	return 0
+}
+
+func (m *ForwardReceipt) GetForwardPortId() string {
+	if m != nil {
+		return m.ForwardPortId
 	}
-	l = len(m.PacketTimeoutHeight)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return ""
+}
+
+func (m *ForwardReceipt) GetForwardChannelId() string {
+	if m != nil {
+		return m.ForwardChannelId
 	}
-	l = len(m.PacketData)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	return ""
+}
+
+func (m *ForwardReceipt) GetForwardSequence() uint64 {
+	if m != nil {
+		return m.ForwardSequence
 	}
-	if m.RefundSequence != 0 {
-		n += 1 + sovGenesis(uint64(m.RefundSequence))
+	return 0
+}
+
+func (m *ForwardReceipt) GetOutcome() ForwardOutcome {
+	if m != nil {
+		return m.Outcome
 	}
-	if m.RetriesRemaining != 0 {
-		n += 1 + sovGenesis(uint64(m.RetriesRemaining))
+	return ForwardOutcomeUnspecified
+}
+
+func (m *ForwardReceipt) GetToken() types.Coin {
+	if m != nil {
+		return m.Token
 	}
-	if m.Timeout != 0 {
-		n += 1 + sovGenesis(uint64(m.Timeout))
+	return types.Coin{}
+}
+
+func (m *ForwardReceipt) GetForwardToken() types.Coin {
+	if m != nil {
+		return m.ForwardToken
+	}
+	return types.Coin{}
+}
+
+func (m *ForwardReceipt) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+func (m *ForwardReceipt) GetRelayerFee() *RelayerFee {
+	if m != nil {
+		return m.RelayerFee
+	}
+	return nil
+}
+
+func (m *ForwardReceipt) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ForwardReceipt) GetRecoveryAddress() string {
+	if m != nil {
+		return m.RecoveryAddress
+	}
+	return ""
+}
+
+func (m *ForwardReceipt) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// PendingRefund is an ICS-20 packet that refunds the tokens of a failed forward
+// to the original sender, sent because the acknowledgement of the original
+// packet cannot be written.
+type PendingRefund struct {
+	// port_id is the port the refund packet was sent from.
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id is the channel the refund packet was sent over.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence is the sequence of the refund packet.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// original_sender_address is the sender of the original packet, who
+	// receives the refund.
+	OriginalSenderAddress string `protobuf:"bytes,4,opt,name=original_sender_address,json=originalSenderAddress,proto3" json:"original_sender_address,omitempty"`
+	// refund_port_id is the port the original packet was received on.
+	RefundPortId string `protobuf:"bytes,5,opt,name=refund_port_id,json=refundPortId,proto3" json:"refund_port_id,omitempty"`
+	// refund_channel_id is the channel the original packet was received on.
+	RefundChannelId string `protobuf:"bytes,6,opt,name=refund_channel_id,json=refundChannelId,proto3" json:"refund_channel_id,omitempty"`
+	// refund_sequence is the sequence of the original packet.
+	RefundSequence uint64 `protobuf:"varint,7,opt,name=refund_sequence,json=refundSequence,proto3" json:"refund_sequence,omitempty"`
+	// token is the refunded token, as denominated on this chain.
+	Token types.Coin `protobuf:"bytes,8,opt,name=token,proto3" json:"token"`
+	// retries_remaining is the number of times the refund is sent again if the
+	// refund packet fails.
+	RetriesRemaining int32 `protobuf:"varint,9,opt,name=retries_remaining,json=retriesRemaining,proto3" json:"retries_remaining,omitempty"`
+	// attempted_channel_ids are the channels the refund was attempted over, in
+	// order.
+	AttemptedChannelIds []string `protobuf:"bytes,10,rep,name=attempted_channel_ids,json=attemptedChannelIds,proto3" json:"attempted_channel_ids,omitempty"`
+	// error is the error of the failed forward.
+	Error string `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *PendingRefund) Reset()         { *m = PendingRefund{} }
+func (m *PendingRefund) String() string { return proto.CompactTextString(m) }
+func (*PendingRefund) ProtoMessage()    {}
+func (*PendingRefund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd4e56ea31af982, []int{10}
+}
+func (m *PendingRefund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingRefund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingRefund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingRefund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRefund.Merge(m, src)
+}
+func (m *PendingRefund) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingRefund) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRefund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingRefund proto.InternalMessageInfo
+
+func (m *PendingRefund) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *PendingRefund) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PendingRefund) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PendingRefund) GetOriginalSenderAddress() string {
+	if m != nil {
+		return m.OriginalSenderAddress
+	}
+	return ""
+}
+
+func (m *PendingRefund) GetRefundPortId() string {
+	if m != nil {
+		return m.RefundPortId
+	}
+	return ""
+}
+
+func (m *PendingRefund) GetRefundChannelId() string {
+	if m != nil {
+		return m.RefundChannelId
+	}
+	return ""
+}
+
+func (m *PendingRefund) GetRefundSequence() uint64 {
+	if m != nil {
+		return m.RefundSequence
+	}
+	return 0
+}
+
+func (m *PendingRefund) GetToken() types.Coin {
+	if m != nil {
+		return m.Token
+	}
+	return types.Coin{}
+}
+
+func (m *PendingRefund) GetRetriesRemaining() int32 {
+	if m != nil {
+		return m.RetriesRemaining
+	}
+	return 0
+}
+
+func (m *PendingRefund) GetAttemptedChannelIds() []string {
+	if m != nil {
+		return m.AttemptedChannelIds
+	}
+	return nil
+}
+
+func (m *PendingRefund) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("packetforward.v1.ForwardOutcome", ForwardOutcome_name, ForwardOutcome_value)
+	proto.RegisterType((*GenesisState)(nil), "packetforward.v1.GenesisState")
+	proto.RegisterType((*Params)(nil), "packetforward.v1.Params")
+	proto.RegisterType((*ChannelEquivalence)(nil), "packetforward.v1.ChannelEquivalence")
+	proto.RegisterType((*InFlightPacket)(nil), "packetforward.v1.InFlightPacket")
+	proto.RegisterType((*InFlightPacketEntry)(nil), "packetforward.v1.InFlightPacketEntry")
+	proto.RegisterType((*RelayerFee)(nil), "packetforward.v1.RelayerFee")
+	proto.RegisterType((*PauseState)(nil), "packetforward.v1.PauseState")
+	proto.RegisterType((*Route)(nil), "packetforward.v1.Route")
+	proto.RegisterType((*RouteHop)(nil), "packetforward.v1.RouteHop")
+	proto.RegisterType((*ForwardReceipt)(nil), "packetforward.v1.ForwardReceipt")
+	proto.RegisterType((*PendingRefund)(nil), "packetforward.v1.PendingRefund")
+}
+
+func init() { proto.RegisterFile("packetforward/v1/genesis.proto", fileDescriptor_afd4e56ea31af982) }
+
+var fileDescriptor_afd4e56ea31af982 = []byte{
+	// 1943 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4b, 0x6f, 0xdb, 0xd8,
+	0xf5, 0xb7, 0x2c, 0x59, 0x96, 0x8e, 0x2c, 0x59, 0xb9, 0x7e, 0xd1, 0xca, 0x7f, 0x24, 0xfd, 0x39,
+	0x69, 0xea, 0x66, 0x1a, 0x69, 0x9c, 0x76, 0x1e, 0x08, 0xda, 0xa2, 0xd1, 0xc3, 0x19, 0x17, 0xa8,
+	0xed, 0x5e, 0xc5, 0x53, 0xa0, 0x40, 0x41, 0x5c, 0x91, 0x57, 0x32, 0x61, 0xf1, 0x11, 0x92, 0x72,
+	0x6c, 0xa0, 0xdd, 0x15, 0x45, 0x91, 0x55, 0x97, 0xed, 0x22, 0xab, 0xee, 0xfa, 0x15, 0xba, 0xe9,
+	0xa2, 0x8b, 0x2c, 0xa7, 0xbb, 0xa2, 0x0b, 0x4f, 0x91, 0x7c, 0x03, 0x7f, 0x82, 0xe2, 0x3e, 0x48,
+	0x91, 0x7a, 0x24, 0x93, 0x41, 0xba, 0xb2, 0xee, 0x79, 0xf3, 0x9e, 0x73, 0x7e, 0xe7, 0x5c, 0x43,
+	0xd5, 0x25, 0xfa, 0x39, 0x0d, 0x06, 0x8e, 0xf7, 0x8c, 0x78, 0x46, 0xf3, 0x62, 0xbf, 0x39, 0xa4,
+	0x36, 0xf5, 0x4d, 0xbf, 0xe1, 0x7a, 0x4e, 0xe0, 0xa0, 0x72, 0x82, 0xdf, 0xb8, 0xd8, 0xaf, 0x6c,
+	0x0e, 0x9d, 0xa1, 0xc3, 0x99, 0x4d, 0xf6, 0x4b, 0xc8, 0x55, 0xaa, 0xba, 0xe3, 0x5b, 0x8e, 0xdf,
+	0xec, 0x13, 0x9f, 0x36, 0x2f, 0xf6, 0xfb, 0x34, 0x20, 0xfb, 0x4d, 0xdd, 0x31, 0xed, 0x90, 0x3f,
+	0x74, 0x9c, 0xe1, 0x88, 0x36, 0xf9, 0xa9, 0x3f, 0x1e, 0x34, 0x8d, 0xb1, 0x47, 0x02, 0xd3, 0x91,
+	0x7c, 0xf5, 0x9f, 0x69, 0x58, 0x7b, 0x2c, 0x3c, 0xf7, 0x02, 0x12, 0x50, 0xf4, 0x29, 0x64, 0x5d,
+	0xe2, 0x11, 0xcb, 0x57, 0x52, 0xf5, 0xd4, 0x5e, 0xe1, 0x81, 0xd2, 0x98, 0x8e, 0xa4, 0x71, 0xc2,
+	0xf9, 0xad, 0xcc, 0xcb, 0xeb, 0xda, 0x12, 0x96, 0xd2, 0xc8, 0x87, 0x5b, 0xa6, 0xad, 0x0d, 0x46,
+	0xe6, 0xf0, 0x2c, 0xd0, 0x84, 0x8a, 0xaf, 0xac, 0xd6, 0xd3, 0x7b, 0x85, 0x07, 0xdf, 0x99, 0x35,
+	0x71, 0x68, 0x1f, 0x70, 0xc9, 0x13, 0xce, 0xe8, 0xda, 0x81, 0x77, 0xd5, 0xaa, 0x33, 0x7b, 0x37,
+	0xd7, 0x35, 0xe5, 0x8a, 0x58, 0xa3, 0x87, 0xea, 0x8c, 0x35, 0x15, 0xaf, 0x9b, 0x09, 0x35, 0x1f,
+	0xb5, 0xa1, 0xe0, 0x92, 0xb1, 0x4f, 0x35, 0x9f, 0xc5, 0xae, 0xa4, 0x79, 0xc4, 0xff, 0x37, 0x2f,
+	0xe2, 0xb1, 0x4f, 0xf9, 0xf7, 0xc9, 0xa8, 0xc1, 0x8d, 0x28, 0xe8, 0x13, 0xc8, 0x7a, 0xce, 0x38,
+	0xa0, 0xbe, 0x92, 0xe1, 0xe1, 0xee, 0xcc, 0xea, 0x63, 0x67, 0x1c, 0xa9, 0x4a, 0x61, 0xd4, 0x82,
+	0x9c, 0x47, 0x75, 0x6a, 0xba, 0x81, 0xaf, 0xac, 0x70, 0xc5, 0xfa, 0xac, 0xe2, 0x81, 0xf8, 0x89,
+	0x85, 0xa0, 0xb4, 0x10, 0xe9, 0xa1, 0x23, 0x58, 0x77, 0xa9, 0x6d, 0x98, 0xf6, 0x50, 0xf3, 0xe8,
+	0x60, 0x6c, 0x1b, 0xbe, 0x92, 0xe5, 0xa6, 0x6a, 0x73, 0xbe, 0x41, 0x08, 0x62, 0x2e, 0x27, 0x2d,
+	0x95, 0xdc, 0x38, 0xd1, 0xff, 0x59, 0x26, 0xb7, 0x5c, 0x4e, 0xab, 0xbf, 0x2f, 0x40, 0x56, 0xe4,
+	0x08, 0xd9, 0x50, 0x1a, 0x50, 0xaa, 0xb9, 0xd4, 0xd3, 0xa9, 0x1d, 0x90, 0x21, 0xe5, 0x59, 0xcd,
+	0xb7, 0x1e, 0x33, 0xf5, 0x7f, 0x5f, 0xd7, 0xee, 0x0e, 0xcd, 0xe0, 0x6c, 0xdc, 0x6f, 0xe8, 0x8e,
+	0xd5, 0x94, 0x95, 0x24, 0xfe, 0xdc, 0xf7, 0x8d, 0xf3, 0x66, 0x70, 0xe5, 0x52, 0xbf, 0xd1, 0xa1,
+	0xfa, 0xcd, 0x75, 0x6d, 0x4b, 0x64, 0x25, 0x69, 0x4d, 0xc5, 0xc5, 0x01, 0xa5, 0x27, 0xd1, 0x19,
+	0xfd, 0x08, 0x8a, 0x16, 0xb5, 0x1c, 0xad, 0x7f, 0x15, 0x50, 0x6d, 0x48, 0x7c, 0x65, 0xb9, 0x9e,
+	0xda, 0xcb, 0xb4, 0x94, 0x9b, 0xeb, 0xda, 0xa6, 0x30, 0x90, 0x60, 0xab, 0xb8, 0xc0, 0xce, 0xad,
+	0xab, 0x80, 0x3e, 0x26, 0xec, 0x4a, 0xd7, 0x2d, 0x72, 0xa9, 0xc9, 0x8f, 0xe6, 0xfa, 0x69, 0xae,
+	0x5f, 0xb9, 0xb9, 0xae, 0x6d, 0x4b, 0xfd, 0xa4, 0x80, 0x8a, 0x8b, 0x16, 0xb9, 0x94, 0xd7, 0xcc,
+	0x6c, 0xfc, 0x14, 0x4a, 0x64, 0x34, 0x72, 0x9e, 0x51, 0x43, 0x33, 0xa8, 0xed, 0x58, 0x22, 0xab,
+	0xf9, 0xd6, 0xee, 0xe4, 0x1b, 0x92, 0x7c, 0x15, 0x17, 0x25, 0xa1, 0xc3, 0xcf, 0xcc, 0x42, 0x7f,
+	0xe4, 0xe8, 0xe7, 0x13, 0x0b, 0x2b, 0xd3, 0x16, 0x92, 0x7c, 0x15, 0x17, 0x25, 0x41, 0x5a, 0xf8,
+	0x73, 0x0a, 0x36, 0x2c, 0x56, 0xbf, 0x32, 0x4e, 0x62, 0x39, 0x63, 0x3b, 0x08, 0x73, 0xbb, 0xdb,
+	0x10, 0x57, 0xdc, 0x60, 0x3d, 0xdb, 0x90, 0x3d, 0xdb, 0x68, 0x3b, 0xa6, 0xdd, 0x3a, 0x92, 0x2d,
+	0x50, 0x91, 0xdf, 0x3a, 0x6b, 0x43, 0xfd, 0xeb, 0xd7, 0xb5, 0xbd, 0x6f, 0x90, 0x34, 0x66, 0xce,
+	0xc7, 0xb7, 0x2c, 0xd3, 0x96, 0x77, 0xf3, 0x48, 0xe8, 0xa3, 0x5f, 0x83, 0x22, 0xcb, 0x4f, 0xf3,
+	0x68, 0x40, 0x6d, 0x86, 0x05, 0x1a, 0x0f, 0x9f, 0xb5, 0x2b, 0xbb, 0xec, 0x0f, 0x6f, 0xae, 0x6b,
+	0x35, 0x11, 0xc0, 0x22, 0x49, 0x15, 0x6f, 0x4b, 0x16, 0x0e, 0x39, 0x2d, 0xce, 0x40, 0xbf, 0x81,
+	0x4d, 0xfd, 0x8c, 0xd8, 0x36, 0x1d, 0x69, 0xf4, 0xe9, 0xd8, 0xbc, 0x20, 0x23, 0x6a, 0xeb, 0xd4,
+	0x57, 0x72, 0xfc, 0xd3, 0xef, 0xcc, 0x96, 0x75, 0x5b, 0x48, 0x77, 0x27, 0xc2, 0xad, 0x0f, 0xe5,
+	0x2d, 0xdc, 0x16, 0x41, 0xcc, 0xb3, 0xa7, 0xe2, 0x0d, 0x7d, 0x46, 0x91, 0xe1, 0xc1, 0xba, 0x41,
+	0x07, 0x64, 0x3c, 0xe2, 0x21, 0x7b, 0x26, 0xf5, 0x95, 0x7c, 0x3d, 0xb5, 0x57, 0x8c, 0x17, 0xd0,
+	0x94, 0x80, 0x8a, 0x4b, 0x92, 0x82, 0x05, 0x01, 0x7d, 0x06, 0x05, 0x56, 0x64, 0xa1, 0x01, 0xe0,
+	0x06, 0xb6, 0x6f, 0xae, 0x6b, 0x68, 0x52, 0x81, 0x91, 0x32, 0x58, 0xe4, 0x32, 0x54, 0xfc, 0x2d,
+	0xec, 0x84, 0xc6, 0xc3, 0xac, 0x05, 0xa6, 0x45, 0x9d, 0x71, 0xa0, 0x14, 0x38, 0x32, 0xed, 0x36,
+	0x04, 0x1a, 0x37, 0x42, 0x34, 0x6e, 0x74, 0x24, 0x1a, 0xb7, 0xee, 0xc9, 0x6f, 0xae, 0x26, 0x83,
+	0x9c, 0xb2, 0xa3, 0xfe, 0xe9, 0xeb, 0x5a, 0x0a, 0x6f, 0x49, 0xae, 0xcc, 0xec, 0x13, 0xc1, 0x43,
+	0x4f, 0x93, 0x45, 0x17, 0xba, 0x5e, 0x7b, 0x9b, 0xeb, 0xbb, 0x8b, 0x8b, 0x2e, 0xe1, 0x36, 0x56,
+	0x4c, 0x71, 0x97, 0xe4, 0x72, 0x5a, 0x5c, 0x29, 0xbe, 0xab, 0x4b, 0x72, 0xb9, 0xc8, 0x25, 0xb9,
+	0x9c, 0x72, 0xa9, 0x43, 0x49, 0x40, 0x65, 0xe4, 0xad, 0xf4, 0x36, 0x6f, 0xff, 0x2f, 0xbd, 0x6d,
+	0x85, 0x45, 0x1d, 0x57, 0x17, 0x8e, 0x8a, 0x82, 0x18, 0x3a, 0xe9, 0x42, 0x99, 0x01, 0x9d, 0x3f,
+	0xb6, 0x2c, 0xe2, 0x5d, 0x69, 0x84, 0x35, 0xc7, 0x7a, 0x3d, 0xb5, 0x97, 0x6b, 0xdd, 0xbe, 0xb9,
+	0xae, 0xed, 0x4c, 0xa0, 0x30, 0x2e, 0xa1, 0x62, 0x86, 0xb5, 0x3d, 0x41, 0x79, 0xc4, 0x08, 0x47,
+	0x80, 0x66, 0xcb, 0x1b, 0xed, 0xc0, 0xaa, 0xeb, 0x78, 0x81, 0x66, 0x1a, 0x02, 0x8c, 0x71, 0x96,
+	0x1d, 0x0f, 0x0d, 0x54, 0x83, 0x42, 0x58, 0xeb, 0xa6, 0xc1, 0xa0, 0x33, 0xbd, 0x97, 0xc7, 0x20,
+	0x49, 0x87, 0x86, 0xaf, 0xfe, 0x6d, 0x15, 0x4a, 0xc9, 0xc9, 0x89, 0x3e, 0x85, 0x1d, 0xc7, 0x33,
+	0x87, 0xa6, 0x4d, 0x46, 0x9a, 0x4f, 0x6d, 0x83, 0x7a, 0x1a, 0x31, 0x0c, 0x8f, 0xfa, 0xbe, 0x34,
+	0xbe, 0x15, 0xb2, 0x7b, 0x9c, 0xfb, 0x48, 0x30, 0xd1, 0x3d, 0xb8, 0x25, 0xef, 0x61, 0xe2, 0x92,
+	0x83, 0x75, 0x1e, 0xaf, 0x0b, 0x46, 0x3b, 0xf4, 0x8b, 0xee, 0x44, 0x57, 0x1e, 0xc6, 0x9d, 0xe6,
+	0x82, 0x6b, 0x82, 0x7a, 0x22, 0xa2, 0xdf, 0x87, 0x2d, 0xd1, 0xdc, 0x9a, 0xef, 0xe9, 0x71, 0xab,
+	0x19, 0x2e, 0x8c, 0x04, 0xb3, 0xe7, 0xe9, 0x13, 0xc3, 0x1f, 0x01, 0x8a, 0xa9, 0x84, 0xc6, 0x57,
+	0x44, 0x14, 0x91, 0xbc, 0xb4, 0xff, 0x39, 0x28, 0x52, 0x58, 0x66, 0x8e, 0xff, 0xf5, 0x03, 0x62,
+	0xb9, 0x4a, 0x96, 0x01, 0x17, 0xde, 0x16, 0x7c, 0x99, 0xc4, 0x27, 0x21, 0x17, 0x3d, 0x88, 0x22,
+	0x0b, 0x35, 0xcf, 0x28, 0xbb, 0x42, 0x8e, 0x77, 0x79, 0xbc, 0x91, 0x50, 0xfb, 0x82, 0xb3, 0x58,
+	0x2e, 0xa4, 0x8e, 0x41, 0x02, 0xa2, 0xe4, 0xea, 0xa9, 0xbd, 0x35, 0x0c, 0x82, 0xd4, 0x21, 0x01,
+	0x41, 0xdf, 0x05, 0x79, 0x4f, 0x9a, 0x4f, 0x9f, 0x8e, 0x59, 0x62, 0x39, 0xd4, 0x64, 0xb0, 0xbc,
+	0xab, 0x9e, 0xa4, 0xa2, 0x8f, 0xd8, 0x4d, 0x73, 0x80, 0xd0, 0x3c, 0x6a, 0x11, 0xd3, 0x36, 0xed,
+	0x21, 0x07, 0x95, 0x15, 0x5c, 0x96, 0x0c, 0x1c, 0xd2, 0x91, 0x02, 0xab, 0x71, 0xc8, 0xc8, 0xe0,
+	0xf0, 0x88, 0xee, 0x40, 0xd1, 0x76, 0x6c, 0x61, 0x9b, 0xf4, 0x47, 0x94, 0xf7, 0x75, 0x0e, 0x27,
+	0x89, 0xa8, 0x03, 0xc5, 0xa8, 0x91, 0x9c, 0x73, 0x6a, 0x47, 0xad, 0xb8, 0x70, 0xe4, 0x88, 0x45,
+	0x62, 0x4d, 0x6a, 0x3d, 0x61, 0x4a, 0xa8, 0xc2, 0x56, 0x1b, 0xdf, 0x19, 0x5d, 0x50, 0x83, 0x77,
+	0x57, 0x0e, 0x47, 0x67, 0xf4, 0x63, 0x28, 0x78, 0x74, 0x44, 0xae, 0xa8, 0xa7, 0x0d, 0x28, 0x55,
+	0xd6, 0x17, 0xad, 0x5c, 0x58, 0x08, 0x1d, 0x50, 0x8a, 0xc1, 0x8b, 0x7e, 0x23, 0x0d, 0x32, 0x03,
+	0x4a, 0x7d, 0xa5, 0xfc, 0xb6, 0x51, 0xf8, 0x31, 0x8b, 0xeb, 0x9d, 0x86, 0x1d, 0x37, 0xcc, 0x92,
+	0xcd, 0x6f, 0x95, 0x5d, 0x87, 0x46, 0x3d, 0xcf, 0xf1, 0x34, 0xdd, 0x31, 0xa8, 0xaf, 0xdc, 0xaa,
+	0xa7, 0xf7, 0x8a, 0x78, 0x23, 0x62, 0x76, 0x19, 0xaf, 0xcd, 0x58, 0xe8, 0x63, 0xd8, 0x24, 0xa3,
+	0x80, 0x7a, 0x36, 0x09, 0x68, 0xbc, 0x72, 0x91, 0xa8, 0xdc, 0x88, 0x37, 0xa9, 0xdc, 0x07, 0xb0,
+	0x45, 0x82, 0x80, 0x5a, 0x6e, 0x40, 0xe3, 0x1d, 0xe4, 0x2b, 0x1b, 0xbc, 0x69, 0x37, 0x22, 0x66,
+	0x3b, 0xd6, 0xbd, 0x29, 0xd8, 0x98, 0xb3, 0xf7, 0xa2, 0x0f, 0x00, 0x62, 0x3e, 0x45, 0xd7, 0xe6,
+	0xa3, 0xae, 0x8f, 0xc3, 0xc5, 0x72, 0x02, 0x2e, 0x2a, 0x90, 0x8b, 0x4a, 0x8f, 0xaf, 0x49, 0x38,
+	0x3a, 0xa3, 0x13, 0x28, 0x4f, 0xef, 0xcf, 0xbc, 0x0f, 0xe7, 0x2e, 0xa9, 0xc9, 0xa0, 0xc2, 0xd5,
+	0x32, 0xb9, 0x6b, 0xab, 0x2f, 0x97, 0x01, 0x26, 0x39, 0x45, 0x03, 0xbe, 0xfd, 0x5e, 0xf0, 0x1a,
+	0x48, 0xbd, 0xff, 0x5c, 0xae, 0x32, 0xe3, 0xcc, 0x8f, 0x01, 0xab, 0x44, 0x3f, 0xe7, 0x6e, 0x96,
+	0xdf, 0xbf, 0x9b, 0x2c, 0xd1, 0xcf, 0x99, 0x97, 0x11, 0x14, 0x42, 0x68, 0x60, 0x9e, 0xd2, 0xef,
+	0xdf, 0x13, 0x48, 0xfb, 0x07, 0x94, 0xaa, 0x63, 0x80, 0xc9, 0x83, 0x04, 0x6d, 0x43, 0x76, 0x38,
+	0x72, 0xfa, 0x64, 0xc4, 0x53, 0x9f, 0xc3, 0xf2, 0xc4, 0xd6, 0x90, 0x99, 0x69, 0x10, 0x5f, 0x43,
+	0x62, 0x4c, 0x35, 0x3e, 0x25, 0x98, 0x41, 0xb9, 0xb7, 0xa6, 0x79, 0x31, 0xca, 0x93, 0xfa, 0x0b,
+	0x58, 0xe1, 0xef, 0x18, 0x84, 0x20, 0x63, 0x13, 0x4b, 0x3e, 0x05, 0x30, 0xff, 0x8d, 0x7e, 0x08,
+	0x99, 0x33, 0xc7, 0xf5, 0xe5, 0x25, 0x57, 0x16, 0x3c, 0x81, 0xbe, 0x70, 0x5c, 0x59, 0x1e, 0x5c,
+	0x5a, 0x6d, 0x41, 0x2e, 0xa4, 0x2f, 0x1e, 0x6b, 0xc9, 0xfa, 0x5e, 0x9e, 0xaa, 0x6f, 0xf5, 0xef,
+	0x2b, 0x50, 0x4a, 0x3e, 0x93, 0x18, 0xb6, 0x4e, 0x0d, 0x35, 0x69, 0xb2, 0x94, 0x1c, 0x66, 0x8b,
+	0x7b, 0x23, 0xe9, 0x33, 0x3d, 0xdd, 0x53, 0xf1, 0xd6, 0xc9, 0x4c, 0xb5, 0xce, 0x5d, 0x58, 0x0f,
+	0x21, 0x34, 0x39, 0x91, 0x42, 0x64, 0x95, 0xf3, 0xe8, 0xfb, 0x80, 0x42, 0xb9, 0x98, 0xab, 0x2c,
+	0x17, 0x2d, 0x4b, 0xce, 0x04, 0x30, 0xbe, 0x07, 0x21, 0x6d, 0x32, 0x2f, 0xf8, 0xba, 0x8d, 0x43,
+	0x6f, 0xd1, 0xc0, 0x78, 0x08, 0xab, 0xce, 0x38, 0xd0, 0x1d, 0x8b, 0xf2, 0xb1, 0x53, 0x7a, 0xc3,
+	0xbb, 0xf2, 0x58, 0xc8, 0xe1, 0x50, 0x01, 0x7d, 0x02, 0x2b, 0x02, 0xf7, 0xf3, 0xdf, 0x0c, 0xf7,
+	0x85, 0xf4, 0xec, 0xd8, 0x80, 0x6f, 0x33, 0x36, 0x42, 0x6c, 0x2f, 0xfc, 0xaf, 0xb0, 0x7d, 0x6a,
+	0xf6, 0xac, 0xbd, 0xe3, 0xec, 0xd9, 0x84, 0x15, 0x3e, 0x10, 0xf8, 0x50, 0xcc, 0x63, 0x71, 0x60,
+	0x99, 0xf1, 0xa8, 0xee, 0x5c, 0x50, 0xb6, 0xc6, 0xc9, 0xd5, 0xa9, 0x14, 0x2e, 0x42, 0x82, 0x1e,
+	0x2e, 0x4d, 0xdb, 0x90, 0x95, 0x9b, 0x03, 0x1b, 0x7b, 0x69, 0x2c, 0x4f, 0xea, 0x3f, 0xd2, 0x50,
+	0x4c, 0x3c, 0xcf, 0xbf, 0x6d, 0x33, 0xbc, 0x11, 0xd3, 0xdf, 0xb0, 0xea, 0x65, 0xde, 0xb4, 0xea,
+	0xcd, 0xae, 0x6f, 0x2b, 0x73, 0xd6, 0xb7, 0xb9, 0x0b, 0x61, 0x76, 0xfe, 0x42, 0x38, 0x67, 0xf7,
+	0x59, 0x9d, 0xbb, 0xfb, 0x44, 0xe5, 0x98, 0x7b, 0xa7, 0x72, 0x9c, 0xbb, 0x32, 0xe5, 0x17, 0xac,
+	0x4c, 0x0b, 0x47, 0x31, 0x2c, 0x1c, 0xc5, 0x93, 0x4a, 0x28, 0xc4, 0x2a, 0xe1, 0xde, 0xef, 0x96,
+	0xa1, 0x94, 0x6c, 0x2c, 0xf4, 0x13, 0xb8, 0x7d, 0x70, 0x8c, 0x7f, 0xf9, 0x08, 0x77, 0xb4, 0xe3,
+	0xd3, 0x27, 0xed, 0xe3, 0x9f, 0x77, 0xb5, 0xd3, 0xa3, 0xde, 0x49, 0xb7, 0x7d, 0x78, 0x70, 0xd8,
+	0xed, 0x94, 0x97, 0x2a, 0x1f, 0x3c, 0x7f, 0x51, 0xdf, 0x4d, 0x2a, 0x9d, 0xda, 0xbe, 0x4b, 0x75,
+	0x73, 0x60, 0x52, 0x83, 0xe5, 0x6c, 0x5a, 0xbf, 0x77, 0xda, 0x6e, 0x77, 0x7b, 0xbd, 0x72, 0xaa,
+	0xb2, 0xfb, 0xfc, 0x45, 0x7d, 0x2b, 0xa9, 0xdb, 0x1b, 0xeb, 0x3a, 0xcb, 0xd9, 0xe7, 0xa0, 0x4c,
+	0xeb, 0xe1, 0xee, 0xc1, 0xe9, 0x51, 0xa7, 0xdb, 0x29, 0x2f, 0x57, 0x2a, 0xcf, 0x5f, 0xd4, 0xb7,
+	0xa7, 0x20, 0x80, 0x5f, 0x3c, 0x35, 0xd0, 0x43, 0xd8, 0x9d, 0xd5, 0x6c, 0x1f, 0x7f, 0xd9, 0xc5,
+	0xdd, 0x4e, 0x39, 0x5d, 0xb9, 0xfd, 0xfc, 0x45, 0x7d, 0x67, 0x5a, 0x95, 0x57, 0x39, 0x35, 0x2a,
+	0x99, 0x3f, 0xfc, 0xa5, 0xba, 0xd4, 0x72, 0x5f, 0xbe, 0xaa, 0xa6, 0xbe, 0x7a, 0x55, 0x4d, 0xfd,
+	0xe7, 0x55, 0x35, 0xf5, 0xc7, 0xd7, 0xd5, 0xa5, 0xaf, 0x5e, 0x57, 0x97, 0xfe, 0xf5, 0xba, 0xba,
+	0xf4, 0xab, 0x2f, 0x67, 0xfb, 0xd5, 0xec, 0xeb, 0xf7, 0x89, 0xeb, 0xfa, 0x4d, 0xcb, 0x34, 0x8c,
+	0x11, 0x7d, 0x46, 0x3c, 0xda, 0x14, 0xfd, 0x78, 0x5f, 0x36, 0xe4, 0xfd, 0x18, 0xe7, 0xe2, 0xb3,
+	0x66, 0xf2, 0xff, 0x9e, 0xbc, 0xc7, 0xfb, 0x59, 0xfe, 0x66, 0xfb, 0xc1, 0x7f, 0x07, 0x00, 0x37,
+	0x92, 0xf5, 0xa4, 0x15, 0x15, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InFlightPackets) > 0 {
+		for iNdEx := len(m.InFlightPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InFlightPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PendingRefunds) > 0 {
+		for iNdEx := len(m.PendingRefunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRefunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Receipts) > 0 {
+		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Receipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.PauseState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeSummaryAcks {
+		i--
+		if m.FeeSummaryAcks {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RefundTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RefundTimeout):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x72
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxForwardTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxForwardTimeout):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x6a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinForwardTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinForwardTimeout):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x62
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DefaultForwardTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DefaultForwardTimeout):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintGenesis(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x5a
+	if m.MaxRetries != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.DefaultRetries != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DefaultRetries))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ChannelEquivalences) > 0 {
+		for iNdEx := len(m.ChannelEquivalences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelEquivalences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.ReceiptRetentionBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ReceiptRetentionBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.MinForwardAmounts) > 0 {
+		for iNdEx := len(m.MinForwardAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinForwardAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.BlockedDenoms) > 0 {
+		for iNdEx := len(m.BlockedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedDenoms[iNdEx])
+			copy(dAtA[i:], m.BlockedDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BlockedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxForwardGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxForwardGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MemoByteGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MemoByteGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.FeePercentage.Size()
+		i -= size
+		if _, err := m.FeePercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelEquivalence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelEquivalence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelEquivalence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelIds) > 0 {
+		for iNdEx := len(m.ChannelIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChannelIds[iNdEx])
+			copy(dAtA[i:], m.ChannelIds[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InFlightPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InFlightPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InFlightPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AttemptedChannelIds) > 0 {
+		for iNdEx := len(m.AttemptedChannelIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AttemptedChannelIds[iNdEx])
+			copy(dAtA[i:], m.AttemptedChannelIds[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AttemptedChannelIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.AlternateChannelId) > 0 {
+		i -= len(m.AlternateChannelId)
+		copy(dAtA[i:], m.AlternateChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AlternateChannelId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.RetriableErrorCodes) > 0 {
+		dAtA8 := make([]byte, len(m.RetriableErrorCodes)*10)
+		var j7 int
+		for _, num := range m.RetriableErrorCodes {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintGenesis(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.RelayerFee != nil {
+		{
+			size, err := m.RelayerFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Resolved {
+		i--
+		if m.Resolved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size, err := m.ForwardToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.Nonrefundable {
+		i--
+		if m.Nonrefundable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.RetriesRemaining != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RetriesRemaining))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.RefundSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RefundSequence))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.PacketData) > 0 {
+		i -= len(m.PacketData)
+		copy(dAtA[i:], m.PacketData)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PacketData)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PacketTimeoutHeight) > 0 {
+		i -= len(m.PacketTimeoutHeight)
+		copy(dAtA[i:], m.PacketTimeoutHeight)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PacketTimeoutHeight)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PacketTimeoutTimestamp != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PacketTimeoutTimestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.PacketSrcPortId) > 0 {
+		i -= len(m.PacketSrcPortId)
+		copy(dAtA[i:], m.PacketSrcPortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PacketSrcPortId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PacketSrcChannelId) > 0 {
+		i -= len(m.PacketSrcChannelId)
+		copy(dAtA[i:], m.PacketSrcChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PacketSrcChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RefundPortId) > 0 {
+		i -= len(m.RefundPortId)
+		copy(dAtA[i:], m.RefundPortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RefundPortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RefundChannelId) > 0 {
+		i -= len(m.RefundChannelId)
+		copy(dAtA[i:], m.RefundChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RefundChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OriginalSenderAddress) > 0 {
+		i -= len(m.OriginalSenderAddress)
+		copy(dAtA[i:], m.OriginalSenderAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.OriginalSenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InFlightPacketEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InFlightPacketEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InFlightPacketEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.InFlightPacket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayerFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayerFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TimeoutFee) > 0 {
+		for iNdEx := len(m.TimeoutFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimeoutFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AckFee) > 0 {
+		for iNdEx := len(m.AckFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AckFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RecvFee) > 0 {
+		for iNdEx := len(m.RecvFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecvFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChannelIds) > 0 {
+		for iNdEx := len(m.ChannelIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChannelIds[iNdEx])
+			copy(dAtA[i:], m.ChannelIds[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Global {
+		i--
+		if m.Global {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Route) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Route) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Route) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RouteHop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteHop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouteHop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.RecoveryAddress) > 0 {
+		i -= len(m.RecoveryAddress)
+		copy(dAtA[i:], m.RecoveryAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RecoveryAddress)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.RelayerFee != nil {
+		{
+			size, err := m.RelayerFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	{
+		size, err := m.ForwardToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.Outcome != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ForwardSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ForwardSequence))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ForwardChannelId) > 0 {
+		i -= len(m.ForwardChannelId)
+		copy(dAtA[i:], m.ForwardChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ForwardChannelId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ForwardPortId) > 0 {
+		i -= len(m.ForwardPortId)
+		copy(dAtA[i:], m.ForwardPortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ForwardPortId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OriginalSender) > 0 {
+		i -= len(m.OriginalSender)
+		copy(dAtA[i:], m.OriginalSender)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.OriginalSender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingRefund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingRefund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.AttemptedChannelIds) > 0 {
+		for iNdEx := len(m.AttemptedChannelIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AttemptedChannelIds[iNdEx])
+			copy(dAtA[i:], m.AttemptedChannelIds[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AttemptedChannelIds[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.RetriesRemaining != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RetriesRemaining))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.RefundSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RefundSequence))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RefundChannelId) > 0 {
+		i -= len(m.RefundChannelId)
+		copy(dAtA[i:], m.RefundChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RefundChannelId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RefundPortId) > 0 {
+		i -= len(m.RefundPortId)
+		copy(dAtA[i:], m.RefundPortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RefundPortId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OriginalSenderAddress) > 0 {
+		i -= len(m.OriginalSenderAddress)
+		copy(dAtA[i:], m.OriginalSenderAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.OriginalSenderAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.PauseState.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Receipts) > 0 {
+		for _, e := range m.Receipts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingRefunds) > 0 {
+		for _, e := range m.PendingRefunds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InFlightPackets) > 0 {
+		for _, e := range m.InFlightPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeePercentage.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.MemoByteGas != 0 {
+		n += 1 + sovGenesis(uint64(m.MemoByteGas))
+	}
+	if m.MaxForwardGas != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxForwardGas))
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BlockedDenoms) > 0 {
+		for _, s := range m.BlockedDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MinForwardAmounts) > 0 {
+		for _, e := range m.MinForwardAmounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ReceiptRetentionBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.ReceiptRetentionBlocks))
+	}
+	if len(m.ChannelEquivalences) > 0 {
+		for _, e := range m.ChannelEquivalences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.DefaultRetries != 0 {
+		n += 1 + sovGenesis(uint64(m.DefaultRetries))
+	}
+	if m.MaxRetries != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxRetries))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DefaultForwardTimeout)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinForwardTimeout)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxForwardTimeout)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RefundTimeout)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.FeeSummaryAcks {
+		n += 2
+	}
+	return n
+}
+
+func (m *ChannelEquivalence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ChannelIds) > 0 {
+		for _, s := range m.ChannelIds {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InFlightPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OriginalSenderAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.RefundChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.RefundPortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PacketSrcChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PacketSrcPortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PacketTimeoutTimestamp != 0 {
+		n += 1 + sovGenesis(uint64(m.PacketTimeoutTimestamp))
+	}
+	l = len(m.PacketTimeoutHeight)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PacketData)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.RefundSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.RefundSequence))
+	}
+	if m.RetriesRemaining != 0 {
+		n += 1 + sovGenesis(uint64(m.RetriesRemaining))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovGenesis(uint64(m.Timeout))
+	}
+	if m.Nonrefundable {
+		n += 2
+	}
+	l = m.ForwardToken.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Resolved {
+		n += 2
+	}
+	if m.RelayerFee != nil {
+		l = m.RelayerFee.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RetriableErrorCodes) > 0 {
+		l = 0
+		for _, e := range m.RetriableErrorCodes {
+			l += sovGenesis(uint64(e))
+		}
+		n += 2 + sovGenesis(uint64(l)) + l
+	}
+	l = len(m.AlternateChannelId)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.AttemptedChannelIds) > 0 {
+		for _, s := range m.AttemptedChannelIds {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InFlightPacketEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	l = m.InFlightPacket.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *RelayerFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecvFee) > 0 {
+		for _, e := range m.RecvFee {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AckFee) > 0 {
+		for _, e := range m.AckFee {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TimeoutFee) > 0 {
+		for _, e := range m.TimeoutFee {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PauseState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Global {
+		n += 2
+	}
+	if len(m.ChannelIds) > 0 {
+		for _, s := range m.ChannelIds {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Route) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Hops) > 0 {
+		for _, e := range m.Hops {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RouteHop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ForwardReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OriginalSender)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	l = len(m.ForwardPortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ForwardChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ForwardSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.ForwardSequence))
+	}
+	if m.Outcome != 0 {
+		n += 1 + sovGenesis(uint64(m.Outcome))
+	}
+	l = m.Token.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.ForwardToken.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.RelayerFee != nil {
+		l = m.RelayerFee.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.RecoveryAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *PendingRefund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	l = len(m.OriginalSenderAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.RefundPortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.RefundChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.RefundSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.RefundSequence))
+	}
+	l = m.Token.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.RetriesRemaining != 0 {
+		n += 1 + sovGenesis(uint64(m.RetriesRemaining))
+	}
+	if len(m.AttemptedChannelIds) > 0 {
+		for _, s := range m.AttemptedChannelIds {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PauseState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, Route{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receipts = append(m.Receipts, ForwardReceipt{})
+			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRefunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRefunds = append(m.PendingRefunds, PendingRefund{})
+			if err := m.PendingRefunds[len(m.PendingRefunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlightPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InFlightPackets = append(m.InFlightPackets, InFlightPacketEntry{})
+			if err := m.InFlightPackets[len(m.InFlightPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoByteGas", wireType)
+			}
+			m.MemoByteGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoByteGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxForwardGas", wireType)
+			}
+			m.MaxForwardGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxForwardGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedDenoms = append(m.BlockedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinForwardAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinForwardAmounts = append(m.MinForwardAmounts, types.Coin{})
+			if err := m.MinForwardAmounts[len(m.MinForwardAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptRetentionBlocks", wireType)
+			}
+			m.ReceiptRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiptRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelEquivalences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelEquivalences = append(m.ChannelEquivalences, ChannelEquivalence{})
+			if err := m.ChannelEquivalences[len(m.ChannelEquivalences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultRetries", wireType)
+			}
+			m.DefaultRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultForwardTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DefaultForwardTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinForwardTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinForwardTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxForwardTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxForwardTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RefundTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSummaryAcks", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FeeSummaryAcks = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelEquivalence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelEquivalence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelEquivalence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelIds = append(m.ChannelIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InFlightPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InFlightPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalSenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSrcChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketSrcChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSrcPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketSrcPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketTimeoutTimestamp", wireType)
+			}
+			m.PacketTimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketTimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketTimeoutHeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketTimeoutHeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketData = append(m.PacketData[:0], dAtA[iNdEx:postIndex]...)
+			if m.PacketData == nil {
+				m.PacketData = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundSequence", wireType)
+			}
+			m.RefundSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefundSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetriesRemaining", wireType)
+			}
+			m.RetriesRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetriesRemaining |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonrefundable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Nonrefundable = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ForwardToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Resolved = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RelayerFee == nil {
+				m.RelayerFee = &RelayerFee{}
+			}
+			if err := m.RelayerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RetriableErrorCodes = append(m.RetriableErrorCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RetriableErrorCodes) == 0 {
+					m.RetriableErrorCodes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RetriableErrorCodes = append(m.RetriableErrorCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetriableErrorCodes", wireType)
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlternateChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AlternateChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttemptedChannelIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttemptedChannelIds = append(m.AttemptedChannelIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InFlightPacketEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InFlightPacketEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InFlightPacketEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlightPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InFlightPacket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayerFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayerFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayerFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecvFee = append(m.RecvFee, types.Coin{})
+			if err := m.RecvFee[len(m.RecvFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckFee = append(m.AckFee, types.Coin{})
+			if err := m.AckFee[len(m.AckFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeoutFee = append(m.TimeoutFee, types.Coin{})
+			if err := m.TimeoutFee[len(m.TimeoutFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Global", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Global = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelIds = append(m.ChannelIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Route) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Route: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Route: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hops = append(m.Hops, RouteHop{})
+			if err := m.Hops[len(m.Hops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	if m.Nonrefundable {
-		n += 2
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *RouteHop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteHop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteHop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *ForwardReceipt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -609,28 +4938,245 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenesis
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardSequence", wireType)
+			}
+			m.ForwardSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= ForwardOutcome(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -657,13 +5203,13 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InFlightPackets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardToken", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -690,159 +5236,83 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InFlightPackets == nil {
-				m.InFlightPackets = make(map[string]InFlightPacket)
+			if err := m.ForwardToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			mapvalue := &InFlightPacket{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &InFlightPacket{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenesis(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenesis
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.InFlightPackets[mapkey] = *mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.RelayerFee == nil {
+				m.RelayerFee = &RelayerFee{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.RelayerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeePercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -870,10 +5340,59 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.RecoveryAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -895,7 +5414,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
+func (m *PendingRefund) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -918,15 +5437,15 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InFlightPacket: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingRefund: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InFlightPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingRefund: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSenderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -954,11 +5473,11 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalSenderAddress = string(dAtA[iNdEx:postIndex])
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefundChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -986,13 +5505,13 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RefundChannelId = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefundPortId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var stringLen uint64
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1002,27 +5521,14 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RefundPortId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketSrcChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSenderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1050,11 +5556,11 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketSrcChannelId = string(dAtA[iNdEx:postIndex])
+			m.OriginalSenderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketSrcPortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundPortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1082,30 +5588,11 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketSrcPortId = string(dAtA[iNdEx:postIndex])
+			m.RefundPortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketTimeoutTimestamp", wireType)
-			}
-			m.PacketTimeoutTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PacketTimeoutTimestamp |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketTimeoutHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1133,13 +5620,13 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketTimeoutHeight = string(dAtA[iNdEx:postIndex])
+			m.RefundChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketData", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundSequence", wireType)
 			}
-			var byteLen int
+			m.RefundSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1149,31 +5636,16 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.RefundSequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PacketData = append(m.PacketData[:0], dAtA[iNdEx:postIndex]...)
-			if m.PacketData == nil {
-				m.PacketData = []byte{}
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefundSequence", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			m.RefundSequence = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1183,12 +5655,26 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RefundSequence |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RetriesRemaining", wireType)
 			}
@@ -1207,11 +5693,11 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttemptedChannelIds", wireType)
 			}
-			m.Timeout = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1221,16 +5707,29 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timeout |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonrefundable", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttemptedChannelIds = append(m.AttemptedChannelIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1240,12 +5739,24 @@ func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Nonrefundable = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,76 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ForwardHooks are called by the packetforward keeper as forwards progress, so that other modules can observe
+// their outcomes. A forward is identified by the port and channel its forwarded packet was sent over and the
+// sequence of the forwarded packet.
+type ForwardHooks interface {
+	// AfterForwardInitiated is called after a forward, or a retry of a forward that timed out, was sent.
+	AfterForwardInitiated(ctx sdk.Context, portID, channelID string, sequence uint64, inFlightPacket InFlightPacket) error
+	// AfterForwardAcked is called after a forward was acknowledged successfully and the acknowledgement of the
+	// original packet was written.
+	AfterForwardAcked(ctx sdk.Context, portID, channelID string, sequence uint64, inFlightPacket InFlightPacket) error
+	// AfterForwardRefunded is called after a forward failed, or timed out without retries left, and the original
+	// packet was acknowledged with an error so that it is refunded on the source chain.
+	AfterForwardRefunded(ctx sdk.Context, portID, channelID string, sequence uint64, inFlightPacket InFlightPacket, reason string) error
+	// AfterForwardRecovered is called after a non-refundable forward failed and its funds were moved to an account
+	// on this chain that the original sender can recover them from.
+	AfterForwardRecovered(ctx sdk.Context, portID, channelID string, sequence uint64, inFlightPacket InFlightPacket, reason string) error
+}
+
+var _ ForwardHooks = MultiForwardHooks{}
+
+// MultiForwardHooks combines multiple forward hooks, which are called in order.
+type MultiForwardHooks []ForwardHooks
+
+// NewMultiForwardHooks creates a new MultiForwardHooks instance.
+func NewMultiForwardHooks(hooks ...ForwardHooks) MultiForwardHooks {
+	return hooks
+}
+
+func (h MultiForwardHooks) AfterForwardInitiated(
+	ctx sdk.Context, portID, channelID string, sequence uint64, inFlightPacket InFlightPacket,
+) error {
+	for i := range h {
+		if err := h[i].AfterForwardInitiated(ctx, portID, channelID, sequence, inFlightPacket); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiForwardHooks) AfterForwardAcked(
+	ctx sdk.Context, portID, channelID string, sequence uint64, inFlightPacket InFlightPacket,
+) error {
+	for i := range h {
+		if err := h[i].AfterForwardAcked(ctx, portID, channelID, sequence, inFlightPacket); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiForwardHooks) AfterForwardRefunded(
+	ctx sdk.Context, portID, channelID string, sequence uint64, inFlightPacket InFlightPacket, reason string,
+) error {
+	for i := range h {
+		if err := h[i].AfterForwardRefunded(ctx, portID, channelID, sequence, inFlightPacket, reason); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiForwardHooks) AfterForwardRecovered(
+	ctx sdk.Context, portID, channelID string, sequence uint64, inFlightPacket InFlightPacket, reason string,
+) error {
+	for i := range h {
+		if err := h[i].AfterForwardRecovered(ctx, portID, channelID, sequence, inFlightPacket, reason); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// NewInFlightPacketEntry creates an in-flight packet entry for the packet forwarded over the given port and channel.
+func NewInFlightPacketEntry(portID, channelID string, sequence uint64, inFlightPacket InFlightPacket) InFlightPacketEntry {
+	return InFlightPacketEntry{
+		ChannelId:      channelID,
+		PortId:         portID,
+		Sequence:       sequence,
+		InFlightPacket: inFlightPacket,
+	}
+}
+
+// Validate performs basic validation of the in-flight packet entry, including the in-flight packet.
+func (e InFlightPacketEntry) Validate() error {
+	if err := host.PortIdentifierValidator(e.PortId); err != nil {
+		return fmt.Errorf("invalid in-flight packet port: %w", err)
+	}
+	if err := host.ChannelIdentifierValidator(e.ChannelId); err != nil {
+		return fmt.Errorf("invalid in-flight packet channel: %w", err)
+	}
+	if e.Sequence == 0 {
+		return fmt.Errorf("in-flight packet sequence cannot be zero")
+	}
+
+	if attempted := e.InFlightPacket.AttemptedChannelIds; len(attempted) > 0 && attempted[len(attempted)-1] != e.ChannelId {
+		return fmt.Errorf("in-flight packet channel %s is not its last attempted channel", e.ChannelId)
+	}
+
+	return e.InFlightPacket.Validate()
+}
+
+// Validate performs basic validation of the in-flight packet. It asserts that the original packet can be
+// acknowledged or refunded from it.
+func (m InFlightPacket) Validate() error {
+	if m.OriginalSenderAddress == "" {
+		return fmt.Errorf("in-flight packet original sender cannot be empty")
+	}
+	if err := host.PortIdentifierValidator(m.RefundPortId); err != nil {
+		return fmt.Errorf("invalid in-flight packet refund port: %w", err)
+	}
+	if err := host.ChannelIdentifierValidator(m.RefundChannelId); err != nil {
+		return fmt.Errorf("invalid in-flight packet refund channel: %w", err)
+	}
+	if m.RefundSequence == 0 {
+		return fmt.Errorf("in-flight packet refund sequence cannot be zero")
+	}
+	if err := host.PortIdentifierValidator(m.PacketSrcPortId); err != nil {
+		return fmt.Errorf("invalid in-flight packet source port: %w", err)
+	}
+	if err := host.ChannelIdentifierValidator(m.PacketSrcChannelId); err != nil {
+		return fmt.Errorf("invalid in-flight packet source channel: %w", err)
+	}
+	if _, err := clienttypes.ParseHeight(m.PacketTimeoutHeight); err != nil {
+		return fmt.Errorf("invalid in-flight packet timeout height: %w", err)
+	}
+	if len(m.PacketData) == 0 {
+		return fmt.Errorf("in-flight packet data cannot be empty")
+	}
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(m.PacketData, &data); err != nil {
+		return fmt.Errorf("in-flight packet data is not fungible token packet data: %w", err)
+	}
+	if err := data.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid in-flight packet data: %w", err)
+	}
+	if m.RetriesRemaining < 0 {
+		return fmt.Errorf("in-flight packet retries remaining cannot be negative")
+	}
+	// the forward token is unset for in-flight packets stored before it was recorded.
+	if m.ForwardToken.Denom != "" {
+		if err := m.ForwardToken.Validate(); err != nil {
+			return fmt.Errorf("invalid in-flight packet forward token: %w", err)
+		}
+		if err := m.validateForwardToken(data); err != nil {
+			return err
+		}
+	}
+	if err := m.Fees.Validate(); err != nil {
+		return fmt.Errorf("invalid in-flight packet fees: %w", err)
+	}
+	if m.AlternateChannelId != "" {
+		if err := host.ChannelIdentifierValidator(m.AlternateChannelId); err != nil {
+			return fmt.Errorf("invalid in-flight packet alternate channel: %w", err)
+		}
+	}
+	for _, channelID := range m.AttemptedChannelIds {
+		if err := host.ChannelIdentifierValidator(channelID); err != nil {
+			return fmt.Errorf("invalid in-flight packet attempted channel: %w", err)
+		}
+	}
+	return nil
+}
+
+// validateForwardToken asserts that the forward token is the token of the original packet data. Its denom is the
+// denom of the received voucher on this chain, or the denom of the packet data if denom composition was disabled.
+// Fees are deducted before the token is forwarded, so its amount may be lower than the amount of the packet data.
+func (m InFlightPacket) validateForwardToken(data transfertypes.FungibleTokenPacketData) error {
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return fmt.Errorf("invalid in-flight packet data amount: %s", data.Amount)
+	}
+	if m.ForwardToken.Amount.GT(amount) {
+		return fmt.Errorf("in-flight packet forward token %s exceeds the packet data amount %s", m.ForwardToken, data.Amount)
+	}
+
+	denomPath := transfertypes.GetDenomPrefix(m.RefundPortId, m.RefundChannelId) + data.Denom
+	if counterpartyPrefix := transfertypes.GetDenomPrefix(m.PacketSrcPortId, m.PacketSrcChannelId); strings.HasPrefix(data.Denom, counterpartyPrefix) {
+		denomPath = data.Denom[len(counterpartyPrefix):]
+	}
+	if denom := transfertypes.ParseDenomTrace(denomPath).IBCDenom(); m.ForwardToken.Denom != denom && m.ForwardToken.Denom != data.Denom {
+		return fmt.Errorf("in-flight packet forward token denom %s does not match the packet data denom %s", m.ForwardToken.Denom, data.Denom)
+	}
+	return nil
+}
+
+// ValidateInFlightPacketEntries validates the in-flight packet entries and asserts that there is at most one per
+// forwarded packet. Every invalid entry is reported, identified by its index and forwarded packet.
+func ValidateInFlightPacketEntries(entries []InFlightPacketEntry) error {
+	var errs []error
+	seen := make(map[string]bool, len(entries))
+	for i, entry := range entries {
+		if err := entry.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("in_flight_packets[%d] %s/%s/%d: %w", i, entry.PortId, entry.ChannelId, entry.Sequence, err))
+			continue
+		}
+
+		key := string(RefundPacketKey(entry.ChannelId, entry.PortId, entry.Sequence))
+		if seen[key] {
+			errs = append(errs, fmt.Errorf("in_flight_packets[%d] %s/%s/%d: duplicate in-flight packet", i, entry.PortId, entry.ChannelId, entry.Sequence))
+		}
+		seen[key] = true
+	}
+	return errors.Join(errs...)
+}
+
+// SortInFlightPacketEntries sorts the in-flight packet entries by the port, channel and sequence of their forwarded
+// packets.
+func SortInFlightPacketEntries(entries []InFlightPacketEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.PortId != b.PortId {
+			return a.PortId < b.PortId
+		}
+		if a.ChannelId != b.ChannelId {
+			return a.ChannelId < b.ChannelId
+		}
+		return a.Sequence < b.Sequence
+	})
+}
@@ -0,0 +1,121 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+)
+
+func TestValidateInFlightPacketEntries(t *testing.T) {
+	entry := types.NewInFlightPacketEntry("transfer", "channel-1", 1, types.InFlightPacket{
+		OriginalSenderAddress:  "cosmos1wnlew8ss0sqclfalvj6jkcyvnwq79fd74qxxue",
+		RefundPortId:           "transfer",
+		RefundChannelId:        "channel-0",
+		RefundSequence:         3,
+		PacketSrcPortId:        "transfer",
+		PacketSrcChannelId:     "channel-5",
+		PacketTimeoutHeight:    "1-100",
+		PacketTimeoutTimestamp: 1,
+		PacketData:             packetData("100", "transfer/channel-5/uatom"),
+		RetriesRemaining:       1,
+		ForwardToken:           sdk.NewInt64Coin("uatom", 90),
+		AttemptedChannelIds:    []string{"channel-2", "channel-1"},
+	})
+	withEntry := func(f func(e *types.InFlightPacketEntry)) types.InFlightPacketEntry {
+		e := entry
+		f(&e)
+		return e
+	}
+
+	for _, tc := range []struct {
+		name    string
+		entries []types.InFlightPacketEntry
+		expErr  bool
+	}{
+		{"empty", nil, false},
+		{"valid", []types.InFlightPacketEntry{entry, withEntry(func(e *types.InFlightPacketEntry) { e.Sequence = 2 })}, false},
+		{"duplicate", []types.InFlightPacketEntry{entry, entry}, true},
+		{"invalid port", []types.InFlightPacketEntry{withEntry(func(e *types.InFlightPacketEntry) { e.PortId = "" })}, true},
+		{"invalid channel", []types.InFlightPacketEntry{withEntry(func(e *types.InFlightPacketEntry) { e.ChannelId = "channel/1" })}, true},
+		{"zero sequence", []types.InFlightPacketEntry{withEntry(func(e *types.InFlightPacketEntry) { e.Sequence = 0 })}, true},
+		{"not last attempted channel", []types.InFlightPacketEntry{withEntry(func(e *types.InFlightPacketEntry) { e.ChannelId = "channel-2" })}, true},
+		{"no attempted channels", []types.InFlightPacketEntry{withEntry(func(e *types.InFlightPacketEntry) { e.InFlightPacket.AttemptedChannelIds = nil })}, false},
+		{"empty sender", []types.InFlightPacketEntry{withEntry(func(e *types.InFlightPacketEntry) { e.InFlightPacket.OriginalSenderAddress = "" })}, true},
+		{"invalid refund channel", []types.InFlightPacketEntry{withEntry(func(e *types.InFlightPacketEntry) { e.InFlightPacket.RefundChannelId = "" })}, true},
+		{"zero refund sequence", []types.InFlightPacketEntry{withEntry(func(e *types.InFlightPacketEntry) { e.InFlightPacket.RefundSequence = 0 })}, true},
+		{"invalid source port", []types.InFlightPacketEntry{withEntry(func(e *types.InFlightPacketEntry) { e.InFlightPacket.PacketSrcPortId = "a" })}, true},
+		{"unparseable timeout height", []types.InFlightPacketEntry{withEntry(func(e *types.InFlightPacketEntry) { e.InFlightPacket.PacketTimeoutHeight = "100" })}, true},
+		{"empty packet data", []types.InFlightPacketEntry{withEntry(func(e *types.InFlightPacketEntry) { e.InFlightPacket.PacketData = nil })}, true},
+		{"negative retries", []types.InFlightPacketEntry{withEntry(func(e *types.InFlightPacketEntry) { e.InFlightPacket.RetriesRemaining = -1 })}, true},
+		{"no forward token", []types.InFlightPacketEntry{withEntry(func(e *types.InFlightPacketEntry) { e.InFlightPacket.ForwardToken = sdk.Coin{} })}, false},
+		{"invalid forward token", []types.InFlightPacketEntry{withEntry(func(e *types.InFlightPacketEntry) {
+			e.InFlightPacket.ForwardToken = sdk.Coin{Denom: "1", Amount: sdk.NewInt(1)}
+		})}, true},
+		{"non-fungible packet data", []types.InFlightPacketEntry{withEntry(func(e *types.InFlightPacketEntry) { e.InFlightPacket.PacketData = []byte("data") })}, true},
+		{"empty fungible packet data", []types.InFlightPacketEntry{withEntry(func(e *types.InFlightPacketEntry) { e.InFlightPacket.PacketData = []byte("{}") })}, true},
+		{"packet data without receiver", []types.InFlightPacketEntry{withEntry(func(e *types.InFlightPacketEntry) {
+			e.InFlightPacket.PacketData = transfertypes.NewFungibleTokenPacketData("uatom", "100", "cosmos1wnlew8ss0sqclfalvj6jkcyvnwq79fd74qxxue", "", "").GetBytes()
+		})}, true},
+		{"forward token exceeds packet amount", []types.InFlightPacketEntry{withEntry(func(e *types.InFlightPacketEntry) {
+			e.InFlightPacket.ForwardToken = sdk.NewInt64Coin("uatom", 101)
+		})}, true},
+		{"forward token of other denom", []types.InFlightPacketEntry{withEntry(func(e *types.InFlightPacketEntry) {
+			e.InFlightPacket.ForwardToken = sdk.NewInt64Coin("uosmo", 90)
+		})}, true},
+		{"forward token of composed denom", []types.InFlightPacketEntry{withEntry(func(e *types.InFlightPacketEntry) {
+			e.InFlightPacket.PacketData = packetData("100", "uosmo")
+			e.InFlightPacket.ForwardToken = sdk.NewInt64Coin(transfertypes.ParseDenomTrace("transfer/channel-0/uosmo").IBCDenom(), 90)
+		})}, false},
+		{"forward token of packet data denom", []types.InFlightPacketEntry{withEntry(func(e *types.InFlightPacketEntry) {
+			e.InFlightPacket.ForwardToken = sdk.NewInt64Coin("transfer/channel-5/uatom", 90)
+		})}, false},
+		{"invalid alternate channel", []types.InFlightPacketEntry{withEntry(func(e *types.InFlightPacketEntry) { e.InFlightPacket.AlternateChannelId = "c" })}, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateInFlightPacketEntries(tc.entries)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSortInFlightPacketEntries(t *testing.T) {
+	entries := []types.InFlightPacketEntry{
+		types.NewInFlightPacketEntry("transfer", "channel-1", 10, types.InFlightPacket{}),
+		types.NewInFlightPacketEntry("transfer", "channel-1", 2, types.InFlightPacket{}),
+		types.NewInFlightPacketEntry("transfer", "channel-0", 3, types.InFlightPacket{}),
+		types.NewInFlightPacketEntry("custom", "channel-1", 1, types.InFlightPacket{}),
+	}
+	types.SortInFlightPacketEntries(entries)
+
+	require.Equal(t, []types.InFlightPacketEntry{
+		types.NewInFlightPacketEntry("custom", "channel-1", 1, types.InFlightPacket{}),
+		types.NewInFlightPacketEntry("transfer", "channel-0", 3, types.InFlightPacket{}),
+		types.NewInFlightPacketEntry("transfer", "channel-1", 2, types.InFlightPacket{}),
+		types.NewInFlightPacketEntry("transfer", "channel-1", 10, types.InFlightPacket{}),
+	}, entries)
+}
+
+func TestValidateInFlightPacketEntries_ReportsEveryEntry(t *testing.T) {
+	err := types.ValidateInFlightPacketEntries([]types.InFlightPacketEntry{
+		types.NewInFlightPacketEntry("transfer", "channel-0", 1, types.InFlightPacket{}),
+		types.NewInFlightPacketEntry("transfer", "channel-1", 2, types.InFlightPacket{}),
+	})
+	require.ErrorContains(t, err, "in_flight_packets[0] transfer/channel-0/1: ")
+	require.ErrorContains(t, err, "in_flight_packets[1] transfer/channel-1/2: ")
+}
+
+// packetData returns the packet data of an original packet of the amount of the denom.
+func packetData(amount, denom string) []byte {
+	return transfertypes.NewFungibleTokenPacketData(
+		denom, amount, "cosmos1wnlew8ss0sqclfalvj6jkcyvnwq79fd74qxxue", "cosmos1c5vm0ykh6el4fy6zqhfjsvm0ez5txmsgqgl4lx", "",
+	).GetBytes()
+}
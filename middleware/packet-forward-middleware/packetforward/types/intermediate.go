@@ -0,0 +1,17 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// IntermediateAccount returns the account that receives the funds of packets forwarded on behalf of
+// the original sender received on the given channel. It is a hash of the channel/origSender so that
+// senders cannot move funds through arbitrary accounts.
+func IntermediateAccount(channel string, originalSender string) sdk.AccAddress {
+	senderStr := fmt.Sprintf("%s/%s", channel, originalSender)
+	senderHash32 := address.Hash(ModuleName, []byte(senderStr))
+	return sdk.AccAddress(senderHash32[:20])
+}
@@ -1,6 +1,14 @@
 package types
 
-import fmt "fmt"
+import (
+	fmt "fmt"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// ModuleName defines the module name
@@ -16,14 +24,119 @@ const (
 	QuerierRoute = ModuleName
 )
 
-var ParamsKey = []byte{0x00}
+// The module state is stored under single byte prefixes. The params and in-flight packets are collections of
+// cosmossdk.io/collections under their prefixes, see docs/integration.md.
+var (
+	ParamsKey     = []byte{0x00}
+	PauseStateKey = []byte{0x01}
+
+	// InFlightPacketKeyPrefix is the prefix of the in-flight packet collection, keyed by InFlightPacketKey.
+	InFlightPacketKeyPrefix = []byte{0x02}
+	// InFlightPacketBySenderKeyPrefix is the prefix of the index of in-flight packets by original sender.
+	InFlightPacketBySenderKeyPrefix = []byte{0x03}
+	// InFlightPacketByRefundChannelKeyPrefix is the prefix of the index of in-flight packets by refund channel.
+	InFlightPacketByRefundChannelKeyPrefix = []byte{0x04}
+	// RouteKeyPrefix is the prefix of registered routes, keyed by name.
+	RouteKeyPrefix = []byte{0x05}
+	// ReceiptKeyPrefix is the prefix of forward receipts, keyed by the original packet.
+	ReceiptKeyPrefix = []byte{0x06}
+	// ReceiptBySenderKeyPrefix is the prefix of the index of forward receipts by original sender.
+	ReceiptBySenderKeyPrefix = []byte{0x07}
+	// ReceiptByHeightKeyPrefix is the prefix of the index of forward receipts by the height they were recorded at.
+	ReceiptByHeightKeyPrefix = []byte{0x08}
+	// PendingRefundKeyPrefix is the prefix of pending refunds, keyed by the refund packet.
+	PendingRefundKeyPrefix = []byte{0x09}
+)
 
+// Context keys of the forward options of previous versions. OnRecvPacket still maps them into ForwardOptions.
 type (
-	NonrefundableKey           struct{}
+	// Deprecated: use ForwardOptions.Nonrefundable with ForwardRequester.OnRecvPacketWithOptions instead.
+	NonrefundableKey struct{}
+	// Deprecated: use ForwardOptions.DisableDenomComposition with ForwardRequester.OnRecvPacketWithOptions instead.
 	DisableDenomCompositionKey struct{}
-	ProcessedKey               struct{}
+	// Deprecated: use ForwardOptions.Processed with ForwardRequester.OnRecvPacketWithOptions instead.
+	ProcessedKey struct{}
 )
 
+// RefundPacketKey returns the identifier of the forwarded packet that its in-flight packet is stored under.
 func RefundPacketKey(channelID, portID string, sequence uint64) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%d", channelID, portID, sequence))
 }
+
+// ParseRefundPacketKey returns the channel, port and sequence of the forwarded packet encoded in the key.
+func ParseRefundPacketKey(key []byte) (channelID, portID string, sequence uint64, err error) {
+	parts := strings.Split(string(key), "/")
+	if len(parts) != 3 {
+		return "", "", 0, fmt.Errorf("invalid refund packet key: %s", key)
+	}
+
+	sequence, err = strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		return "", "", 0, fmt.Errorf("invalid refund packet key sequence: %w", err)
+	}
+
+	return parts[0], parts[1], sequence, nil
+}
+
+// InFlightPacketKey is the key of the in-flight packet of a forwarded packet in the in-flight packet collection,
+// made of the channel and port the packet was forwarded over, and its sequence.
+type InFlightPacketKey = collections.Pair[collections.Pair[string, string], uint64]
+
+// InFlightPacketKeyCodec is the key codec of the in-flight packet collection.
+var InFlightPacketKeyCodec = collections.PairKeyCodec(
+	collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+	collections.Uint64Key,
+)
+
+// NewInFlightPacketKey returns the key of the in-flight packet of the packet forwarded over the given channel.
+func NewInFlightPacketKey(channelID, portID string, sequence uint64) InFlightPacketKey {
+	return collections.Join(collections.Join(channelID, portID), sequence)
+}
+
+// RouteKey returns the key a route is stored under.
+func RouteKey(name string) []byte {
+	return concat(RouteKeyPrefix, []byte(name))
+}
+
+// ReceiptKey returns the store key of the forward receipt of an original packet.
+func ReceiptKey(portID, channelID string, sequence uint64) []byte {
+	return concat(ReceiptKeyPrefix, RefundPacketKey(channelID, portID, sequence))
+}
+
+// ReceiptBySenderPrefix returns the index prefix of the forward receipts of an original sender.
+func ReceiptBySenderPrefix(originalSender string) []byte {
+	return concat(ReceiptBySenderKeyPrefix, lengthPrefix([]byte(originalSender)))
+}
+
+// ReceiptBySenderKey returns the index key of a forward receipt by its original sender.
+func ReceiptBySenderKey(originalSender, portID, channelID string, sequence uint64) []byte {
+	return concat(ReceiptBySenderPrefix(originalSender), RefundPacketKey(channelID, portID, sequence))
+}
+
+// ReceiptByHeightPrefix returns the index prefix of the forward receipts recorded at a height.
+func ReceiptByHeightPrefix(height int64) []byte {
+	return concat(ReceiptByHeightKeyPrefix, sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// ReceiptByHeightKey returns the index key of a forward receipt by the height it was recorded at.
+func ReceiptByHeightKey(height int64, portID, channelID string, sequence uint64) []byte {
+	return concat(ReceiptByHeightPrefix(height), RefundPacketKey(channelID, portID, sequence))
+}
+
+// PendingRefundKey returns the store key of the pending refund sent in a refund packet.
+func PendingRefundKey(portID, channelID string, sequence uint64) []byte {
+	return concat(PendingRefundKeyPrefix, RefundPacketKey(channelID, portID, sequence))
+}
+
+// lengthPrefix prefixes bz with its length so that variable length key parts cannot collide.
+func lengthPrefix(bz []byte) []byte {
+	return concat(sdk.Uint64ToBigEndian(uint64(len(bz))), bz)
+}
+
+func concat(parts ...[]byte) []byte {
+	var key []byte
+	for _, part := range parts {
+		key = append(key, part...)
+	}
+	return key
+}
@@ -1,12 +1,23 @@
 package types
 
 import (
+	"strings"
+
 	"cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 )
 
-var _ sdk.Msg = &MsgUpdateParams{}
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetForwardingPaused{}
+	_ sdk.Msg = &MsgSweepIntermediateAccount{}
+	_ sdk.Msg = &MsgSetRoute{}
+	_ sdk.Msg = &MsgRemoveRoute{}
+)
 
 // GetSignBytes implements the LegacyMsg interface.
 func (m MsgUpdateParams) GetSignBytes() []byte {
@@ -27,3 +38,95 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 
 	return m.Params.Validate()
 }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetForwardingPaused) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSetForwardingPaused message.
+func (m *MsgSetForwardingPaused) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSetForwardingPaused) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	return validatePauseTargets(m.ChannelIds, m.Denoms)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSweepIntermediateAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSweepIntermediateAccount message.
+func (m *MsgSweepIntermediateAccount) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Signer)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSweepIntermediateAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errors.Wrap(err, "invalid signer address")
+	}
+
+	if err := host.PortIdentifierValidator(m.PortId); err != nil {
+		return errors.Wrap(err, "invalid port")
+	}
+
+	if err := host.ChannelIdentifierValidator(m.ChannelId); err != nil {
+		return errors.Wrap(err, "invalid channel")
+	}
+
+	if strings.TrimSpace(m.OriginalSender) == "" {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, "original sender cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetRoute) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSetRoute message.
+func (m *MsgSetRoute) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSetRoute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	return m.Route.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRemoveRoute) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgRemoveRoute message.
+func (m *MsgRemoveRoute) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRemoveRoute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	return validateRouteName(m.Name)
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// ForwardOptions change how the packet-forward-middleware handles a forward. They are set by middlewares that
+// wrap the packet-forward-middleware, through the ForwardRequester interface.
+type ForwardOptions struct {
+	// Nonrefundable moves the funds of a failed forward to the account of the original sender on this chain
+	// instead of refunding them on the source chain.
+	Nonrefundable bool
+
+	// DisableDenomComposition forwards the denom of the packet data as is, instead of composing the denom of the
+	// received voucher on this chain. It requires Processed, since the funds are otherwise received as a voucher.
+	DisableDenomComposition bool
+
+	// Processed indicates that the funds of the packet were already received on this chain by the calling
+	// middleware, so the packet-forward-middleware must not pass the packet to the underlying application.
+	Processed bool
+}
+
+// Validate performs basic validation of the forward options.
+func (o ForwardOptions) Validate() error {
+	if o.DisableDenomComposition && !o.Processed {
+		return fmt.Errorf("invalid forward options %s: denom composition can only be disabled for processed packets", o)
+	}
+	return nil
+}
+
+// String returns the names of the options that are set, or "none".
+func (o ForwardOptions) String() string {
+	var set []string
+	if o.Nonrefundable {
+		set = append(set, "nonrefundable")
+	}
+	if o.DisableDenomComposition {
+		set = append(set, "disable_denom_composition")
+	}
+	if o.Processed {
+		set = append(set, "processed")
+	}
+	if len(set) == 0 {
+		return "none"
+	}
+	return strings.Join(set, ",")
+}
+
+// ForwardRequester is implemented by the packet-forward-middleware. Middlewares that wrap it call
+// OnRecvPacketWithOptions instead of OnRecvPacket to change how a forward is handled.
+type ForwardRequester interface {
+	// OnRecvPacketWithOptions handles a received packet like OnRecvPacket, with the given forward options.
+	OnRecvPacketWithOptions(
+		ctx sdk.Context,
+		packet channeltypes.Packet,
+		relayer sdk.AccAddress,
+		opts ForwardOptions,
+	) ibcexported.Acknowledgement
+}
@@ -0,0 +1,21 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForwardOptions(t *testing.T) {
+	require.NoError(t, types.ForwardOptions{}.Validate())
+	require.Equal(t, "none", types.ForwardOptions{}.String())
+
+	opts := types.ForwardOptions{Nonrefundable: true, DisableDenomComposition: true, Processed: true}
+	require.NoError(t, opts.Validate())
+	require.Equal(t, "nonrefundable,disable_denom_composition,processed", opts.String())
+
+	// the funds of unprocessed packets are received as a voucher, so its denom is always composed.
+	opts = types.ForwardOptions{DisableDenomComposition: true}
+	require.ErrorContains(t, opts.Validate(), "disable_denom_composition")
+}
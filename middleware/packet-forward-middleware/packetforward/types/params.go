@@ -2,18 +2,135 @@ package types
 
 import (
 	"fmt"
+	"math"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 )
 
-// DefaultFeePercentage is the default value used to extract a fee from all forwarded packets.
-var DefaultFeePercentage = sdk.NewDec(0)
+var (
+	// DefaultFeePercentage is the default value used to extract a fee from all forwarded packets.
+	DefaultFeePercentage = sdk.NewDec(0)
+
+	// DefaultMemoByteGas is the default gas consumed per byte of the memo of a forwarded packet.
+	DefaultMemoByteGas uint64 = 10
+
+	// DefaultMaxForwardGas is the default gas limit of a single forward, zero disables the limit.
+	DefaultMaxForwardGas uint64 = 0
+
+	// DefaultReceiptRetentionBlocks is the default number of blocks forward receipts are retained for, zero
+	// disables forward receipts.
+	DefaultReceiptRetentionBlocks uint64 = 0
+
+	// DefaultRetries is the default number of retries of a forward that does not set retries.
+	DefaultRetries uint32 = 0
+
+	// DefaultMaxRetries is the default maximum number of retries of a forward.
+	DefaultMaxRetries uint32 = 10
+
+	// DefaultForwardTimeout is the default timeout of a forward that does not set a timeout, following IBC defaults.
+	DefaultForwardTimeout = time.Duration(transfertypes.DefaultRelativePacketTimeoutTimestamp)
+
+	// DefaultMinForwardTimeout is the default minimum timeout of a forward.
+	DefaultMinForwardTimeout = time.Minute
+
+	// DefaultMaxForwardTimeout is the default maximum timeout of a forward.
+	DefaultMaxForwardTimeout = 28 * 24 * time.Hour
 
-// NewParams creates a new parameter configuration for the pfm module.
+	// DefaultRefundTimeout is the default 28-day timeout of refund packets, since funds are stuck in the
+	// packetforward module otherwise.
+	DefaultRefundTimeout = 28 * 24 * time.Hour
+)
+
+// NewParams creates a new parameter configuration for the pfm module. The other parameters take their default
+// values and are set with the With methods.
 func NewParams(feePercentage sdk.Dec) Params {
-	return Params{
-		FeePercentage: feePercentage,
+	params := Params{
+		FeePercentage:          feePercentage,
+		MemoByteGas:            DefaultMemoByteGas,
+		MaxForwardGas:          DefaultMaxForwardGas,
+		ReceiptRetentionBlocks: DefaultReceiptRetentionBlocks,
 	}
+	return params.WithDefaultForwardLimits()
+}
+
+// WithMemoByteGas returns the params with the gas consumed per byte of the memo of a forwarded packet.
+func (p Params) WithMemoByteGas(memoByteGas uint64) Params {
+	p.MemoByteGas = memoByteGas
+	return p
+}
+
+// WithMaxForwardGas returns the params with the gas limit of a single forward.
+func (p Params) WithMaxForwardGas(maxForwardGas uint64) Params {
+	p.MaxForwardGas = maxForwardGas
+	return p
+}
+
+// WithAllowedDenoms returns the params with the only denoms that may be forwarded.
+func (p Params) WithAllowedDenoms(denoms ...string) Params {
+	p.AllowedDenoms = denoms
+	return p
+}
+
+// WithBlockedDenoms returns the params with the denoms that may not be forwarded.
+func (p Params) WithBlockedDenoms(denoms ...string) Params {
+	p.BlockedDenoms = denoms
+	return p
+}
+
+// WithMinForwardAmounts returns the params with the minimum amounts of the denoms that may be forwarded.
+func (p Params) WithMinForwardAmounts(amounts sdk.Coins) Params {
+	p.MinForwardAmounts = amounts
+	return p
+}
+
+// WithReceiptRetentionBlocks returns the params with the number of blocks forward receipts are retained for.
+func (p Params) WithReceiptRetentionBlocks(blocks uint64) Params {
+	p.ReceiptRetentionBlocks = blocks
+	return p
+}
+
+// WithChannelEquivalences returns the params with the groups of channels that forwards fail over between.
+func (p Params) WithChannelEquivalences(equivalences ...ChannelEquivalence) Params {
+	p.ChannelEquivalences = equivalences
+	return p
+}
+
+// WithForwardRetries returns the params with the default and maximum retries of forwards.
+func (p Params) WithForwardRetries(defaultRetries, maxRetries uint32) Params {
+	p.DefaultRetries = defaultRetries
+	p.MaxRetries = maxRetries
+	return p
+}
+
+// WithForwardTimeouts returns the params with the default, minimum and maximum timeouts of forwards.
+func (p Params) WithForwardTimeouts(defaultTimeout, minTimeout, maxTimeout time.Duration) Params {
+	p.DefaultForwardTimeout = defaultTimeout
+	p.MinForwardTimeout = minTimeout
+	p.MaxForwardTimeout = maxTimeout
+	return p
+}
+
+// WithRefundTimeout returns the params with the timeout of refund packets.
+func (p Params) WithRefundTimeout(refundTimeout time.Duration) Params {
+	p.RefundTimeout = refundTimeout
+	return p
+}
+
+// WithFeeSummaryAcks returns the params with fee summary acknowledgements enabled or disabled.
+func (p Params) WithFeeSummaryAcks(enabled bool) Params {
+	p.FeeSummaryAcks = enabled
+	return p
+}
+
+// WithDefaultForwardLimits returns the params with the retries and timeouts of forwards set to their defaults.
+func (p Params) WithDefaultForwardLimits() Params {
+	return p.
+		WithForwardRetries(DefaultRetries, DefaultMaxRetries).
+		WithForwardTimeouts(DefaultForwardTimeout, DefaultMinForwardTimeout, DefaultMaxForwardTimeout).
+		WithRefundTimeout(DefaultRefundTimeout)
 }
 
 // DefaultParams is the default parameter configuration for the pfm module.
@@ -23,7 +140,104 @@ func DefaultParams() Params {
 
 // Validate the pfm module parameters.
 func (p Params) Validate() error {
-	return validateFeePercentage(p.FeePercentage)
+	if err := validateFeePercentage(p.FeePercentage); err != nil {
+		return err
+	}
+	if err := validateDenomList("allowed", p.AllowedDenoms); err != nil {
+		return err
+	}
+	if err := validateDenomList("blocked", p.BlockedDenoms); err != nil {
+		return err
+	}
+	for _, denom := range p.BlockedDenoms {
+		if p.IsDenomAllowListed(denom) {
+			return fmt.Errorf("denom %s cannot be both allowed and blocked", denom)
+		}
+	}
+	if err := p.MinForwardAmounts.Validate(); err != nil {
+		return fmt.Errorf("invalid min forward amounts: %w", err)
+	}
+	if err := validateChannelEquivalences(p.ChannelEquivalences); err != nil {
+		return err
+	}
+	return p.ValidateForwardLimits()
+}
+
+// ValidateForwardLimits asserts that the default retries and timeout of forwards are within their bounds.
+func (p Params) ValidateForwardLimits() error {
+	if p.MaxRetries > math.MaxUint8 {
+		return fmt.Errorf("max retries must not exceed %d, got %d", math.MaxUint8, p.MaxRetries)
+	}
+	if p.DefaultRetries > p.MaxRetries {
+		return fmt.Errorf("default retries %d exceed max retries %d", p.DefaultRetries, p.MaxRetries)
+	}
+	if p.MinForwardTimeout <= 0 {
+		return fmt.Errorf("min forward timeout must be positive, got %s", p.MinForwardTimeout)
+	}
+	if p.MaxForwardTimeout < p.MinForwardTimeout {
+		return fmt.Errorf("max forward timeout %s is shorter than min forward timeout %s", p.MaxForwardTimeout, p.MinForwardTimeout)
+	}
+	if p.DefaultForwardTimeout < p.MinForwardTimeout || p.DefaultForwardTimeout > p.MaxForwardTimeout {
+		return fmt.Errorf("default forward timeout %s is not between %s and %s",
+			p.DefaultForwardTimeout, p.MinForwardTimeout, p.MaxForwardTimeout)
+	}
+	if p.RefundTimeout <= 0 {
+		return fmt.Errorf("refund timeout must be positive, got %s", p.RefundTimeout)
+	}
+	return nil
+}
+
+// ForwardRetries returns the number of retries of a forward. Retries set in the memo are clamped to the max
+// retries, and the default retries are used if the memo does not set retries.
+func (p Params) ForwardRetries(retries *uint8) uint8 {
+	if retries == nil {
+		return uint8(p.DefaultRetries)
+	}
+	if uint32(*retries) > p.MaxRetries {
+		return uint8(p.MaxRetries)
+	}
+	return *retries
+}
+
+// ForwardTimeout returns the timeout of a forward. A timeout set in the memo is clamped to the min and max forward
+// timeouts, and the default forward timeout is used if the memo does not set a timeout.
+func (p Params) ForwardTimeout(timeout time.Duration) time.Duration {
+	switch {
+	case timeout <= 0:
+		return p.DefaultForwardTimeout
+	case timeout < p.MinForwardTimeout:
+		return p.MinForwardTimeout
+	case timeout > p.MaxForwardTimeout:
+		return p.MaxForwardTimeout
+	default:
+		return timeout
+	}
+}
+
+// IsDenomAllowListed returns true if the denom is in the list of allowed denoms.
+func (p Params) IsDenomAllowListed(denom string) bool {
+	return contains(p.AllowedDenoms, denom)
+}
+
+// IsDenomForwardable returns true if the denom may be forwarded according to the allowed and blocked denoms.
+func (p Params) IsDenomForwardable(denom string) bool {
+	if len(p.AllowedDenoms) > 0 && !p.IsDenomAllowListed(denom) {
+		return false
+	}
+	return !contains(p.BlockedDenoms, denom)
+}
+
+// validateDenomList asserts that the denoms are valid, sorted and unique.
+func validateDenomList(name string, denoms []string) error {
+	for i, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid %s denom: %w", name, err)
+		}
+		if i > 0 && denoms[i-1] >= denom {
+			return fmt.Errorf("%s denoms must be sorted and unique", name)
+		}
+	}
+	return nil
 }
 
 // validateFeePercentage asserts that the fee percentage param is a valid sdk.Dec type.
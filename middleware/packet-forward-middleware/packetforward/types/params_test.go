@@ -0,0 +1,146 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	newParams := func(allowedDenoms, blockedDenoms []string, minForwardAmounts sdk.Coins) types.Params {
+		return types.DefaultParams().
+			WithAllowedDenoms(allowedDenoms...).
+			WithBlockedDenoms(blockedDenoms...).
+			WithMinForwardAmounts(minForwardAmounts)
+	}
+	withLimits := func(update func(params *types.Params)) types.Params {
+		params := types.DefaultParams()
+		update(&params)
+		return params
+	}
+	withEquivalences := func(equivalences ...types.ChannelEquivalence) types.Params {
+		params := types.DefaultParams()
+		params.ChannelEquivalences = equivalences
+		return params
+	}
+
+	for _, tc := range []struct {
+		name   string
+		params types.Params
+		expErr bool
+	}{
+		{"default", types.DefaultParams(), false},
+		{"denom lists", newParams([]string{"uatom", "uosmo"}, []string{"ujuno"}, nil), false},
+		{"min forward amounts", newParams(nil, nil, sdk.NewCoins(sdk.NewInt64Coin("uatom", 10))), false},
+		{"negative fee percentage", types.NewParams(sdk.NewDec(-1)), true},
+		{"invalid allowed denom", newParams([]string{"1atom"}, nil, nil), true},
+		{"unsorted allowed denoms", newParams([]string{"uosmo", "uatom"}, nil, nil), true},
+		{"duplicate blocked denoms", newParams(nil, []string{"uatom", "uatom"}, nil), true},
+		{"allowed and blocked", newParams([]string{"uatom"}, []string{"uatom"}, nil), true},
+		{"zero min forward amount", newParams(nil, nil, sdk.Coins{sdk.NewInt64Coin("uatom", 0)}), true},
+		{"channel equivalences", withEquivalences(
+			types.NewChannelEquivalence("transfer", "channel-0", "channel-1"),
+			types.NewChannelEquivalence("transfer", "channel-2", "channel-3", "channel-4"),
+		), false},
+		{"single channel equivalence", withEquivalences(types.NewChannelEquivalence("transfer", "channel-0")), true},
+		{"invalid equivalent channel", withEquivalences(types.NewChannelEquivalence("transfer", "channel-0", "c")), true},
+		{"duplicate equivalent channel", withEquivalences(types.NewChannelEquivalence("transfer", "channel-0", "channel-0")), true},
+		{"max retries", withLimits(func(p *types.Params) { p.DefaultRetries, p.MaxRetries = 255, 255 }), false},
+		{"max retries above uint8", withLimits(func(p *types.Params) { p.MaxRetries = 256 }), true},
+		{"default retries above max", withLimits(func(p *types.Params) { p.DefaultRetries = p.MaxRetries + 1 }), true},
+		{"zero min forward timeout", withLimits(func(p *types.Params) { p.MinForwardTimeout = 0 }), true},
+		{"max below min forward timeout", withLimits(func(p *types.Params) { p.MaxForwardTimeout = p.MinForwardTimeout - 1 }), true},
+		{"default below min forward timeout", withLimits(func(p *types.Params) { p.DefaultForwardTimeout = p.MinForwardTimeout - 1 }), true},
+		{"default above max forward timeout", withLimits(func(p *types.Params) { p.DefaultForwardTimeout = p.MaxForwardTimeout + 1 }), true},
+		{"zero refund timeout", withLimits(func(p *types.Params) { p.RefundTimeout = 0 }), true},
+		{"unset forward limits", types.Params{FeePercentage: types.DefaultFeePercentage}, true},
+		{"overlapping channel equivalences", withEquivalences(
+			types.NewChannelEquivalence("transfer", "channel-0", "channel-1"),
+			types.NewChannelEquivalence("transfer", "channel-1", "channel-2"),
+		), true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsUnsetForwardLimits(t *testing.T) {
+	// params stored before the migration to consensus version 4 have no forward limits, which are invalid.
+	params := types.Params{FeePercentage: types.DefaultFeePercentage}
+	require.Error(t, params.Validate())
+	require.Error(t, params.ValidateForwardLimits())
+
+	// governance and genesis must set them.
+	authority := sdk.AccAddress([]byte("authority")).String()
+	require.Error(t, (&types.MsgUpdateParams{Authority: authority, Params: params}).ValidateBasic())
+	require.NoError(t, (&types.MsgUpdateParams{Authority: authority, Params: types.DefaultParams()}).ValidateBasic())
+
+	gs := types.DefaultGenesisState()
+	gs.Params = params
+	require.Error(t, gs.Validate())
+}
+
+func TestParamsIsDenomForwardable(t *testing.T) {
+	params := types.DefaultParams()
+	require.True(t, params.IsDenomForwardable("uatom"))
+
+	params.BlockedDenoms = []string{"uatom"}
+	require.False(t, params.IsDenomForwardable("uatom"))
+	require.True(t, params.IsDenomForwardable("uosmo"))
+
+	params.AllowedDenoms = []string{"ujuno"}
+	require.False(t, params.IsDenomForwardable("uosmo"))
+	require.True(t, params.IsDenomForwardable("ujuno"))
+}
+
+func TestParamsEquivalentChannels(t *testing.T) {
+	params := types.DefaultParams()
+	params.ChannelEquivalences = []types.ChannelEquivalence{
+		types.NewChannelEquivalence("transfer", "channel-2", "channel-0", "channel-1"),
+	}
+
+	require.Equal(t, []string{"channel-2", "channel-1"}, params.EquivalentChannels("transfer", "channel-0"))
+	require.Empty(t, params.EquivalentChannels("transfer", "channel-3"))
+	require.Empty(t, params.EquivalentChannels("other", "channel-0"))
+}
+
+func TestIsDenomTraceCompatible(t *testing.T) {
+	// native tokens and vouchers of other channels are sent as this chain's tokens over both channels.
+	require.True(t, types.IsDenomTraceCompatible("transfer", "channel-0", "channel-1", "uatom"))
+	require.True(t, types.IsDenomTraceCompatible("transfer", "channel-0", "channel-1", "transfer/channel-2/uatom"))
+
+	// vouchers return to their source over one channel only.
+	require.False(t, types.IsDenomTraceCompatible("transfer", "channel-0", "channel-1", "transfer/channel-0/uatom"))
+	require.False(t, types.IsDenomTraceCompatible("transfer", "channel-0", "channel-1", "transfer/channel-1/uatom"))
+}
+
+func TestParamsForwardRetries(t *testing.T) {
+	params := types.DefaultParams()
+	params.DefaultRetries, params.MaxRetries = 2, 5
+
+	retries := func(n uint8) *uint8 { return &n }
+	require.Equal(t, uint8(2), params.ForwardRetries(nil))
+	require.Equal(t, uint8(0), params.ForwardRetries(retries(0)))
+	require.Equal(t, uint8(5), params.ForwardRetries(retries(5)))
+	require.Equal(t, uint8(5), params.ForwardRetries(retries(255)))
+}
+
+func TestParamsForwardTimeout(t *testing.T) {
+	params := types.DefaultParams()
+	params.DefaultForwardTimeout, params.MinForwardTimeout, params.MaxForwardTimeout = 10*time.Minute, time.Minute, time.Hour
+
+	require.Equal(t, 10*time.Minute, params.ForwardTimeout(0))
+	require.Equal(t, time.Minute, params.ForwardTimeout(time.Second))
+	require.Equal(t, 30*time.Minute, params.ForwardTimeout(30*time.Minute))
+	require.Equal(t, time.Hour, params.ForwardTimeout(24*time.Hour))
+}
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// NewPauseState creates a new PauseState instance with sorted, de-duplicated channels and denoms.
+func NewPauseState(global bool, channelIDs []string, denoms []string) PauseState {
+	return PauseState{
+		Global:     global,
+		ChannelIds: sortedUnique(channelIDs),
+		Denoms:     sortedUnique(denoms),
+	}
+}
+
+// Update returns a copy of the pause state with the given channels and denoms paused or resumed.
+// If no channels or denoms are given, the global pause flag is updated instead.
+func (ps PauseState) Update(paused bool, channelIDs []string, denoms []string) PauseState {
+	if len(channelIDs) == 0 && len(denoms) == 0 {
+		return NewPauseState(paused, ps.ChannelIds, ps.Denoms)
+	}
+
+	if paused {
+		return NewPauseState(ps.Global, append(ps.ChannelIds, channelIDs...), append(ps.Denoms, denoms...))
+	}
+
+	return NewPauseState(ps.Global, without(ps.ChannelIds, channelIDs), without(ps.Denoms, denoms))
+}
+
+// IsChannelPaused returns true if new forwards over the given channel are halted.
+func (ps PauseState) IsChannelPaused(channelID string) bool {
+	return ps.Global || contains(ps.ChannelIds, channelID)
+}
+
+// IsDenomPaused returns true if new forwards of the given denom are halted.
+func (ps PauseState) IsDenomPaused(denom string) bool {
+	return ps.Global || contains(ps.Denoms, denom)
+}
+
+// Validate performs basic validation of the pause state.
+func (ps PauseState) Validate() error {
+	if err := validatePauseTargets(ps.ChannelIds, ps.Denoms); err != nil {
+		return err
+	}
+	if !sort.StringsAreSorted(ps.ChannelIds) || !sort.StringsAreSorted(ps.Denoms) {
+		return fmt.Errorf("paused channels and denoms must be sorted")
+	}
+	return nil
+}
+
+func validatePauseTargets(channelIDs []string, denoms []string) error {
+	for _, channelID := range channelIDs {
+		if err := host.ChannelIdentifierValidator(channelID); err != nil {
+			return fmt.Errorf("invalid paused channel: %w", err)
+		}
+	}
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid paused denom: %w", err)
+		}
+	}
+	return nil
+}
+
+func contains(list []string, s string) bool {
+	i := sort.SearchStrings(list, s)
+	return i < len(list) && list[i] == s
+}
+
+func sortedUnique(list []string) []string {
+	if len(list) == 0 {
+		return nil
+	}
+	sorted := append([]string(nil), list...)
+	sort.Strings(sorted)
+
+	res := sorted[:1]
+	for _, s := range sorted[1:] {
+		if s != res[len(res)-1] {
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+func without(list []string, remove []string) []string {
+	var res []string
+	for _, s := range list {
+		found := false
+		for _, r := range remove {
+			if s == r {
+				found = true
+				break
+			}
+		}
+		if !found {
+			res = append(res, s)
+		}
+	}
+	return res
+}
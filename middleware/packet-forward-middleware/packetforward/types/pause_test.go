@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPauseStateUpdate(t *testing.T) {
+	ps := types.PauseState{}
+
+	ps = ps.Update(true, []string{"channel-1", "channel-0", "channel-1"}, []string{"uatom"})
+	require.False(t, ps.Global)
+	require.Equal(t, []string{"channel-0", "channel-1"}, ps.ChannelIds)
+	require.Equal(t, []string{"uatom"}, ps.Denoms)
+	require.NoError(t, ps.Validate())
+
+	require.True(t, ps.IsChannelPaused("channel-0"))
+	require.False(t, ps.IsChannelPaused("channel-2"))
+	require.True(t, ps.IsDenomPaused("uatom"))
+	require.False(t, ps.IsDenomPaused("uosmo"))
+
+	// global pause retains the per channel and denom pauses.
+	ps = ps.Update(true, nil, nil)
+	require.True(t, ps.Global)
+	require.True(t, ps.IsChannelPaused("channel-2"))
+	require.True(t, ps.IsDenomPaused("uosmo"))
+	require.Equal(t, []string{"channel-0", "channel-1"}, ps.ChannelIds)
+
+	ps = ps.Update(false, nil, nil)
+	require.False(t, ps.Global)
+
+	ps = ps.Update(false, []string{"channel-0"}, []string{"uatom"})
+	require.Equal(t, []string{"channel-1"}, ps.ChannelIds)
+	require.Empty(t, ps.Denoms)
+}
+
+func TestPauseStateValidate(t *testing.T) {
+	require.NoError(t, types.PauseState{}.Validate())
+	require.Error(t, types.PauseState{ChannelIds: []string{"x"}}.Validate())
+	require.Error(t, types.PauseState{Denoms: []string{"1"}}.Validate())
+	require.Error(t, types.PauseState{ChannelIds: []string{"channel-1", "channel-0"}}.Validate())
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// Validate performs basic validation of the pending refund.
+func (r PendingRefund) Validate() error {
+	if err := host.PortIdentifierValidator(r.PortId); err != nil {
+		return fmt.Errorf("invalid pending refund port: %w", err)
+	}
+	if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+		return fmt.Errorf("invalid pending refund channel: %w", err)
+	}
+	if r.OriginalSenderAddress == "" {
+		return fmt.Errorf("pending refund original sender cannot be empty")
+	}
+	if err := host.PortIdentifierValidator(r.RefundPortId); err != nil {
+		return fmt.Errorf("invalid pending refund refund port: %w", err)
+	}
+	if err := host.ChannelIdentifierValidator(r.RefundChannelId); err != nil {
+		return fmt.Errorf("invalid pending refund refund channel: %w", err)
+	}
+	if err := r.Token.Validate(); err != nil || !r.Token.IsPositive() {
+		return fmt.Errorf("invalid pending refund token %s", r.Token)
+	}
+	if r.RetriesRemaining < 0 {
+		return fmt.Errorf("pending refund retries remaining cannot be negative")
+	}
+	return nil
+}
+
+// ValidatePendingRefunds validates the pending refunds and asserts that there is at most one per refund packet.
+func ValidatePendingRefunds(refunds []PendingRefund) error {
+	seen := make(map[string]bool, len(refunds))
+	for _, refund := range refunds {
+		if err := refund.Validate(); err != nil {
+			return err
+		}
+
+		key := string(PendingRefundKey(refund.PortId, refund.ChannelId, refund.Sequence))
+		if seen[key] {
+			return fmt.Errorf("duplicate pending refund of packet %s/%s/%d", refund.PortId, refund.ChannelId, refund.Sequence)
+		}
+		seen[key] = true
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidatePendingRefunds(t *testing.T) {
+	refund := types.PendingRefund{
+		PortId:                "transfer",
+		ChannelId:             "channel-1",
+		Sequence:              1,
+		OriginalSenderAddress: "cosmos1wnlew8ss0sqclfalvj6jkcyvnwq79fd74qxxue",
+		RefundPortId:          "transfer",
+		RefundChannelId:       "channel-0",
+		RefundSequence:        1,
+		Token:                 sdk.NewInt64Coin("uatom", 100),
+		RetriesRemaining:      1,
+		AttemptedChannelIds:   []string{"channel-0", "channel-1"},
+	}
+	withRefund := func(f func(r *types.PendingRefund)) types.PendingRefund {
+		r := refund
+		f(&r)
+		return r
+	}
+
+	for _, tc := range []struct {
+		name    string
+		refunds []types.PendingRefund
+		expErr  bool
+	}{
+		{"empty", nil, false},
+		{"valid", []types.PendingRefund{refund, withRefund(func(r *types.PendingRefund) { r.Sequence = 2 })}, false},
+		{"duplicate", []types.PendingRefund{refund, withRefund(func(r *types.PendingRefund) { r.RefundSequence = 2 })}, true},
+		{"invalid channel", []types.PendingRefund{withRefund(func(r *types.PendingRefund) { r.ChannelId = "" })}, true},
+		{"empty sender", []types.PendingRefund{withRefund(func(r *types.PendingRefund) { r.OriginalSenderAddress = "" })}, true},
+		{"invalid refund port", []types.PendingRefund{withRefund(func(r *types.PendingRefund) { r.RefundPortId = "" })}, true},
+		{"zero token", []types.PendingRefund{withRefund(func(r *types.PendingRefund) { r.Token = sdk.NewInt64Coin("uatom", 0) })}, true},
+		{"negative retries", []types.PendingRefund{withRefund(func(r *types.PendingRefund) { r.RetriesRemaining = -1 })}, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidatePendingRefunds(tc.refunds)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
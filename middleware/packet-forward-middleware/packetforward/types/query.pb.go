@@ -6,6 +6,10 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -111,216 +115,3940 @@ func (m *QueryParamsResponse) GetParams() *Params {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "packetforward.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "packetforward.v1.QueryParamsResponse")
+// QueryPauseStateRequest is the request type for the Query/PauseState RPC method.
+type QueryPauseStateRequest struct {
 }
 
-func init() { proto.RegisterFile("packetforward/v1/query.proto", fileDescriptor_358c54bd2cc154d0) }
+func (m *QueryPauseStateRequest) Reset()         { *m = QueryPauseStateRequest{} }
+func (m *QueryPauseStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseStateRequest) ProtoMessage()    {}
+func (*QueryPauseStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358c54bd2cc154d0, []int{2}
+}
+func (m *QueryPauseStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPauseStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPauseStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseStateRequest.Merge(m, src)
+}
+func (m *QueryPauseStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauseStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseStateRequest.DiscardUnknown(m)
+}
 
-var fileDescriptor_358c54bd2cc154d0 = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0x63, 0x24, 0x32, 0x98, 0x05, 0x19, 0x86, 0x2a, 0xaa, 0x2c, 0x08, 0x20, 0xc1, 0x90,
-	0x98, 0x96, 0x81, 0x9d, 0x85, 0x15, 0x18, 0x18, 0xd8, 0x9c, 0xc4, 0x04, 0x8b, 0x26, 0xe7, 0xda,
-	0x4e, 0xaa, 0x6e, 0x88, 0x91, 0x09, 0x89, 0x97, 0x62, 0xac, 0xc4, 0xc2, 0x88, 0x12, 0x1e, 0x04,
-	0x35, 0xc9, 0x40, 0x9b, 0xa1, 0xeb, 0xfd, 0xff, 0x7d, 0xfe, 0x7c, 0x78, 0xa8, 0x78, 0xfc, 0x2c,
-	0xec, 0x23, 0xe8, 0x19, 0xd7, 0x09, 0x2b, 0x47, 0x6c, 0x5a, 0x08, 0x3d, 0x0f, 0x95, 0x06, 0x0b,
-	0x64, 0x77, 0x25, 0x0d, 0xcb, 0x91, 0x37, 0x4c, 0x01, 0xd2, 0x89, 0x60, 0x5c, 0x49, 0xc6, 0xf3,
-	0x1c, 0x2c, 0xb7, 0x12, 0x72, 0xd3, 0xf6, 0x3d, 0xda, 0xa3, 0xa5, 0x22, 0x17, 0x46, 0x76, 0xb9,
-	0xbf, 0x8f, 0xc9, 0xed, 0x12, 0x7f, 0xc3, 0x35, 0xcf, 0xcc, 0x9d, 0x98, 0x16, 0xc2, 0x58, 0xff,
-	0x1a, 0xef, 0xad, 0x4c, 0x8d, 0x82, 0xdc, 0x08, 0x72, 0x8e, 0x5d, 0xd5, 0x4c, 0x06, 0xe8, 0x00,
-	0x9d, 0xee, 0x8c, 0x07, 0xe1, 0xba, 0x4d, 0xd8, 0x6d, 0x74, 0xbd, 0xf1, 0x1b, 0xc2, 0xdb, 0x0d,
-	0x89, 0xbc, 0x20, 0xec, 0xb6, 0x21, 0x39, 0xee, 0xaf, 0xf5, 0x1d, 0xbc, 0x93, 0x0d, 0xad, 0xd6,
-	0xc9, 0x3f, 0x7b, 0xfd, 0xfa, 0xfd, 0xd8, 0x3a, 0x22, 0x87, 0x4c, 0x46, 0x31, 0xe3, 0x4a, 0x19,
-	0xd6, 0xfb, 0x72, 0x2b, 0x73, 0xa5, 0x3e, 0x2b, 0x8a, 0x16, 0x15, 0x45, 0x3f, 0x15, 0x45, 0xef,
-	0x35, 0x75, 0x16, 0x35, 0x75, 0xbe, 0x6b, 0xea, 0x3c, 0xdc, 0xa7, 0xd2, 0x3e, 0x15, 0x51, 0x18,
-	0x43, 0xc6, 0x62, 0x30, 0x19, 0x98, 0x25, 0x2d, 0x68, 0x68, 0x99, 0x4c, 0x92, 0x89, 0x98, 0x71,
-	0x2d, 0x3a, 0x70, 0xd0, 0x91, 0x83, 0x7f, 0x49, 0x79, 0xb9, 0xf6, 0xaa, 0x9d, 0x2b, 0x61, 0x22,
-	0xb7, 0x39, 0xf2, 0xc5, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe0, 0x52, 0xe6, 0xbf, 0xd4, 0x01,
-	0x00, 0x00,
+var xxx_messageInfo_QueryPauseStateRequest proto.InternalMessageInfo
+
+// QueryPauseStateResponse is the response type for the Query/PauseState RPC method.
+type QueryPauseStateResponse struct {
+	// pause_state defines which forwards are currently halted.
+	PauseState *PauseState `protobuf:"bytes,1,opt,name=pause_state,json=pauseState,proto3" json:"pause_state,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryPauseStateResponse) Reset()         { *m = QueryPauseStateResponse{} }
+func (m *QueryPauseStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseStateResponse) ProtoMessage()    {}
+func (*QueryPauseStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358c54bd2cc154d0, []int{3}
+}
+func (m *QueryPauseStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPauseStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPauseStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseStateResponse.Merge(m, src)
+}
+func (m *QueryPauseStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauseStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseStateResponse.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryPauseStateResponse proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries all parameters of the packetforward module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryPauseStateResponse) GetPauseState() *PauseState {
+	if m != nil {
+		return m.PauseState
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryInFlightPacketsBySenderRequest is the request type for the
+// Query/InFlightPacketsBySender RPC method.
+type QueryInFlightPacketsBySenderRequest struct {
+	// original_sender is the sender of the original packet on the source chain.
+	OriginalSender string `protobuf:"bytes,1,opt,name=original_sender,json=originalSender,proto3" json:"original_sender,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryInFlightPacketsBySenderRequest) Reset()         { *m = QueryInFlightPacketsBySenderRequest{} }
+func (m *QueryInFlightPacketsBySenderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInFlightPacketsBySenderRequest) ProtoMessage()    {}
+func (*QueryInFlightPacketsBySenderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358c54bd2cc154d0, []int{4}
+}
+func (m *QueryInFlightPacketsBySenderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInFlightPacketsBySenderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInFlightPacketsBySenderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInFlightPacketsBySenderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInFlightPacketsBySenderRequest.Merge(m, src)
+}
+func (m *QueryInFlightPacketsBySenderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInFlightPacketsBySenderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInFlightPacketsBySenderRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/packetforward.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryInFlightPacketsBySenderRequest proto.InternalMessageInfo
+
+func (m *QueryInFlightPacketsBySenderRequest) GetOriginalSender() string {
+	if m != nil {
+		return m.OriginalSender
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries all parameters of the packetforward module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+func (m *QueryInFlightPacketsBySenderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryInFlightPacketsBySenderResponse is the response type for the
+// Query/InFlightPacketsBySender RPC method.
+type QueryInFlightPacketsBySenderResponse struct {
+	// in_flight_packets are the in-flight packets of the original sender.
+	InFlightPackets []InFlightPacketEntry `protobuf:"bytes,1,rep,name=in_flight_packets,json=inFlightPackets,proto3" json:"in_flight_packets"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryInFlightPacketsBySenderResponse) Reset()         { *m = QueryInFlightPacketsBySenderResponse{} }
+func (m *QueryInFlightPacketsBySenderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInFlightPacketsBySenderResponse) ProtoMessage()    {}
+func (*QueryInFlightPacketsBySenderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358c54bd2cc154d0, []int{5}
+}
+func (m *QueryInFlightPacketsBySenderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInFlightPacketsBySenderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInFlightPacketsBySenderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInFlightPacketsBySenderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInFlightPacketsBySenderResponse.Merge(m, src)
+}
+func (m *QueryInFlightPacketsBySenderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInFlightPacketsBySenderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInFlightPacketsBySenderResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryInFlightPacketsBySenderResponse proto.InternalMessageInfo
+
+func (m *QueryInFlightPacketsBySenderResponse) GetInFlightPackets() []InFlightPacketEntry {
+	if m != nil {
+		return m.InFlightPackets
+	}
+	return nil
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryInFlightPacketsBySenderResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+	return nil
+}
+
+// QueryInFlightPacketsByRefundChannelRequest is the request type for the
+// Query/InFlightPacketsByRefundChannel RPC method.
+type QueryInFlightPacketsByRefundChannelRequest struct {
+	// port_id is the port the original packet was received on.
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id is the channel the original packet was received on.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInFlightPacketsByRefundChannelRequest) Reset() {
+	*m = QueryInFlightPacketsByRefundChannelRequest{}
+}
+func (m *QueryInFlightPacketsByRefundChannelRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryInFlightPacketsByRefundChannelRequest) ProtoMessage() {}
+func (*QueryInFlightPacketsByRefundChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358c54bd2cc154d0, []int{6}
+}
+func (m *QueryInFlightPacketsByRefundChannelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInFlightPacketsByRefundChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInFlightPacketsByRefundChannelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/packetforward.v1.Query/Params",
+}
+func (m *QueryInFlightPacketsByRefundChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInFlightPacketsByRefundChannelRequest.Merge(m, src)
+}
+func (m *QueryInFlightPacketsByRefundChannelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInFlightPacketsByRefundChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInFlightPacketsByRefundChannelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInFlightPacketsByRefundChannelRequest proto.InternalMessageInfo
+
+func (m *QueryInFlightPacketsByRefundChannelRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return ""
+}
+
+func (m *QueryInFlightPacketsByRefundChannelRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "packetforward.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "packetforward/v1/query.proto",
+func (m *QueryInFlightPacketsByRefundChannelRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// QueryInFlightPacketsByRefundChannelResponse is the response type for the
+// Query/InFlightPacketsByRefundChannel RPC method.
+type QueryInFlightPacketsByRefundChannelResponse struct {
+	// in_flight_packets are the in-flight packets refunded over the channel.
+	InFlightPackets []InFlightPacketEntry `protobuf:"bytes,1,rep,name=in_flight_packets,json=inFlightPackets,proto3" json:"in_flight_packets"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInFlightPacketsByRefundChannelResponse) Reset() {
+	*m = QueryInFlightPacketsByRefundChannelResponse{}
+}
+func (m *QueryInFlightPacketsByRefundChannelResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryInFlightPacketsByRefundChannelResponse) ProtoMessage() {}
+func (*QueryInFlightPacketsByRefundChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358c54bd2cc154d0, []int{7}
+}
+func (m *QueryInFlightPacketsByRefundChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInFlightPacketsByRefundChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInFlightPacketsByRefundChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryInFlightPacketsByRefundChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInFlightPacketsByRefundChannelResponse.Merge(m, src)
+}
+func (m *QueryInFlightPacketsByRefundChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInFlightPacketsByRefundChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInFlightPacketsByRefundChannelResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryInFlightPacketsByRefundChannelResponse proto.InternalMessageInfo
+
+func (m *QueryInFlightPacketsByRefundChannelResponse) GetInFlightPackets() []InFlightPacketEntry {
+	if m != nil {
+		return m.InFlightPackets
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryInFlightPacketsByRefundChannelResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// QueryIntermediateAccountRequest is the request type for the
+// Query/IntermediateAccount RPC method.
+type QueryIntermediateAccountRequest struct {
+	// channel_id is the channel on this chain the original packet was received
+	// on.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// original_sender is the sender of the original packet on the source chain.
+	OriginalSender string `protobuf:"bytes,2,opt,name=original_sender,json=originalSender,proto3" json:"original_sender,omitempty"`
+}
+
+func (m *QueryIntermediateAccountRequest) Reset()         { *m = QueryIntermediateAccountRequest{} }
+func (m *QueryIntermediateAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIntermediateAccountRequest) ProtoMessage()    {}
+func (*QueryIntermediateAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358c54bd2cc154d0, []int{8}
+}
+func (m *QueryIntermediateAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntermediateAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntermediateAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryIntermediateAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntermediateAccountRequest.Merge(m, src)
+}
+func (m *QueryIntermediateAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntermediateAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntermediateAccountRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryIntermediateAccountRequest proto.InternalMessageInfo
+
+func (m *QueryIntermediateAccountRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryIntermediateAccountRequest) GetOriginalSender() string {
+	if m != nil {
+		return m.OriginalSender
+	}
+	return ""
+}
+
+// QueryIntermediateAccountResponse is the response type for the
+// Query/IntermediateAccount RPC method.
+type QueryIntermediateAccountResponse struct {
+	// address is the address of the intermediate account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// balances are the funds held by the intermediate account.
+	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
+}
+
+func (m *QueryIntermediateAccountResponse) Reset()         { *m = QueryIntermediateAccountResponse{} }
+func (m *QueryIntermediateAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIntermediateAccountResponse) ProtoMessage()    {}
+func (*QueryIntermediateAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358c54bd2cc154d0, []int{9}
+}
+func (m *QueryIntermediateAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntermediateAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntermediateAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIntermediateAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntermediateAccountResponse.Merge(m, src)
+}
+func (m *QueryIntermediateAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntermediateAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntermediateAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIntermediateAccountResponse proto.InternalMessageInfo
+
+func (m *QueryIntermediateAccountResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryIntermediateAccountResponse) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+// QueryRoutesRequest is the request type for the Query/Routes RPC method.
+type QueryRoutesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRoutesRequest) Reset()         { *m = QueryRoutesRequest{} }
+func (m *QueryRoutesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRoutesRequest) ProtoMessage()    {}
+func (*QueryRoutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358c54bd2cc154d0, []int{10}
+}
+func (m *QueryRoutesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoutesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoutesRequest.Merge(m, src)
+}
+func (m *QueryRoutesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoutesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoutesRequest proto.InternalMessageInfo
+
+func (m *QueryRoutesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRoutesResponse is the response type for the Query/Routes RPC method.
+type QueryRoutesResponse struct {
+	// routes are the registered routes, sorted by name.
+	Routes []Route `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRoutesResponse) Reset()         { *m = QueryRoutesResponse{} }
+func (m *QueryRoutesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRoutesResponse) ProtoMessage()    {}
+func (*QueryRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358c54bd2cc154d0, []int{11}
+}
+func (m *QueryRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoutesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoutesResponse.Merge(m, src)
+}
+func (m *QueryRoutesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoutesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoutesResponse proto.InternalMessageInfo
+
+func (m *QueryRoutesResponse) GetRoutes() []Route {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *QueryRoutesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRouteRequest is the request type for the Query/Route RPC method.
+type QueryRouteRequest struct {
+	// name is the name of the route.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryRouteRequest) Reset()         { *m = QueryRouteRequest{} }
+func (m *QueryRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRouteRequest) ProtoMessage()    {}
+func (*QueryRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358c54bd2cc154d0, []int{12}
+}
+func (m *QueryRouteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRouteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRouteRequest.Merge(m, src)
+}
+func (m *QueryRouteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRouteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRouteRequest proto.InternalMessageInfo
+
+func (m *QueryRouteRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// QueryRouteResponse is the response type for the Query/Route RPC method.
+type QueryRouteResponse struct {
+	// route is the registered route.
+	Route Route `protobuf:"bytes,1,opt,name=route,proto3" json:"route"`
+}
+
+func (m *QueryRouteResponse) Reset()         { *m = QueryRouteResponse{} }
+func (m *QueryRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRouteResponse) ProtoMessage()    {}
+func (*QueryRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358c54bd2cc154d0, []int{13}
+}
+func (m *QueryRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRouteResponse.Merge(m, src)
+}
+func (m *QueryRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRouteResponse proto.InternalMessageInfo
+
+func (m *QueryRouteResponse) GetRoute() Route {
+	if m != nil {
+		return m.Route
+	}
+	return Route{}
+}
+
+// QueryReceiptRequest is the request type for the Query/Receipt RPC method.
+type QueryReceiptRequest struct {
+	// port_id is the port the original packet was received on.
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id is the channel the original packet was received on.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence is the sequence of the original packet.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QueryReceiptRequest) Reset()         { *m = QueryReceiptRequest{} }
+func (m *QueryReceiptRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReceiptRequest) ProtoMessage()    {}
+func (*QueryReceiptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358c54bd2cc154d0, []int{14}
+}
+func (m *QueryReceiptRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReceiptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReceiptRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReceiptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReceiptRequest.Merge(m, src)
+}
+func (m *QueryReceiptRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReceiptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReceiptRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReceiptRequest proto.InternalMessageInfo
+
+func (m *QueryReceiptRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryReceiptRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryReceiptRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// QueryReceiptResponse is the response type for the Query/Receipt RPC method.
+type QueryReceiptResponse struct {
+	// receipt is the forward receipt of the original packet.
+	Receipt ForwardReceipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt"`
+}
+
+func (m *QueryReceiptResponse) Reset()         { *m = QueryReceiptResponse{} }
+func (m *QueryReceiptResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReceiptResponse) ProtoMessage()    {}
+func (*QueryReceiptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358c54bd2cc154d0, []int{15}
+}
+func (m *QueryReceiptResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReceiptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReceiptResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReceiptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReceiptResponse.Merge(m, src)
+}
+func (m *QueryReceiptResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReceiptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReceiptResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReceiptResponse proto.InternalMessageInfo
+
+func (m *QueryReceiptResponse) GetReceipt() ForwardReceipt {
+	if m != nil {
+		return m.Receipt
+	}
+	return ForwardReceipt{}
+}
+
+// QueryReceiptsBySenderRequest is the request type for the
+// Query/ReceiptsBySender RPC method.
+type QueryReceiptsBySenderRequest struct {
+	// original_sender is the sender of the original packet on the source chain.
+	OriginalSender string `protobuf:"bytes,1,opt,name=original_sender,json=originalSender,proto3" json:"original_sender,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryReceiptsBySenderRequest) Reset()         { *m = QueryReceiptsBySenderRequest{} }
+func (m *QueryReceiptsBySenderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReceiptsBySenderRequest) ProtoMessage()    {}
+func (*QueryReceiptsBySenderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358c54bd2cc154d0, []int{16}
+}
+func (m *QueryReceiptsBySenderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReceiptsBySenderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReceiptsBySenderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReceiptsBySenderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReceiptsBySenderRequest.Merge(m, src)
+}
+func (m *QueryReceiptsBySenderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReceiptsBySenderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReceiptsBySenderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReceiptsBySenderRequest proto.InternalMessageInfo
+
+func (m *QueryReceiptsBySenderRequest) GetOriginalSender() string {
+	if m != nil {
+		return m.OriginalSender
+	}
+	return ""
+}
+
+func (m *QueryReceiptsBySenderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryReceiptsBySenderResponse is the response type for the
+// Query/ReceiptsBySender RPC method.
+type QueryReceiptsBySenderResponse struct {
+	// receipts are the forward receipts of the original sender.
+	Receipts []ForwardReceipt `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryReceiptsBySenderResponse) Reset()         { *m = QueryReceiptsBySenderResponse{} }
+func (m *QueryReceiptsBySenderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReceiptsBySenderResponse) ProtoMessage()    {}
+func (*QueryReceiptsBySenderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_358c54bd2cc154d0, []int{17}
+}
+func (m *QueryReceiptsBySenderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReceiptsBySenderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReceiptsBySenderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReceiptsBySenderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReceiptsBySenderResponse.Merge(m, src)
+}
+func (m *QueryReceiptsBySenderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReceiptsBySenderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReceiptsBySenderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReceiptsBySenderResponse proto.InternalMessageInfo
+
+func (m *QueryReceiptsBySenderResponse) GetReceipts() []ForwardReceipt {
+	if m != nil {
+		return m.Receipts
+	}
+	return nil
+}
+
+func (m *QueryReceiptsBySenderResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "packetforward.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "packetforward.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryPauseStateRequest)(nil), "packetforward.v1.QueryPauseStateRequest")
+	proto.RegisterType((*QueryPauseStateResponse)(nil), "packetforward.v1.QueryPauseStateResponse")
+	proto.RegisterType((*QueryInFlightPacketsBySenderRequest)(nil), "packetforward.v1.QueryInFlightPacketsBySenderRequest")
+	proto.RegisterType((*QueryInFlightPacketsBySenderResponse)(nil), "packetforward.v1.QueryInFlightPacketsBySenderResponse")
+	proto.RegisterType((*QueryInFlightPacketsByRefundChannelRequest)(nil), "packetforward.v1.QueryInFlightPacketsByRefundChannelRequest")
+	proto.RegisterType((*QueryInFlightPacketsByRefundChannelResponse)(nil), "packetforward.v1.QueryInFlightPacketsByRefundChannelResponse")
+	proto.RegisterType((*QueryIntermediateAccountRequest)(nil), "packetforward.v1.QueryIntermediateAccountRequest")
+	proto.RegisterType((*QueryIntermediateAccountResponse)(nil), "packetforward.v1.QueryIntermediateAccountResponse")
+	proto.RegisterType((*QueryRoutesRequest)(nil), "packetforward.v1.QueryRoutesRequest")
+	proto.RegisterType((*QueryRoutesResponse)(nil), "packetforward.v1.QueryRoutesResponse")
+	proto.RegisterType((*QueryRouteRequest)(nil), "packetforward.v1.QueryRouteRequest")
+	proto.RegisterType((*QueryRouteResponse)(nil), "packetforward.v1.QueryRouteResponse")
+	proto.RegisterType((*QueryReceiptRequest)(nil), "packetforward.v1.QueryReceiptRequest")
+	proto.RegisterType((*QueryReceiptResponse)(nil), "packetforward.v1.QueryReceiptResponse")
+	proto.RegisterType((*QueryReceiptsBySenderRequest)(nil), "packetforward.v1.QueryReceiptsBySenderRequest")
+	proto.RegisterType((*QueryReceiptsBySenderResponse)(nil), "packetforward.v1.QueryReceiptsBySenderResponse")
+}
+
+func init() { proto.RegisterFile("packetforward/v1/query.proto", fileDescriptor_358c54bd2cc154d0) }
+
+var fileDescriptor_358c54bd2cc154d0 = []byte{
+	// 1120 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0xcf, 0xa4, 0xcd, 0x9f, 0xbe, 0x20, 0xda, 0x4e, 0x22, 0xb2, 0xac, 0xd2, 0x4d, 0x70, 0xfe,
+	0x6d, 0x83, 0xe2, 0x49, 0x52, 0x15, 0x2e, 0x54, 0xd0, 0x54, 0x4d, 0x08, 0x12, 0x28, 0x6c, 0x45,
+	0x5b, 0x21, 0xd0, 0x6a, 0xd6, 0x9e, 0x38, 0x56, 0x77, 0x6d, 0xd7, 0xe3, 0x4d, 0x15, 0x45, 0x91,
+	0x10, 0xe2, 0x0e, 0x08, 0xc1, 0x37, 0x40, 0x48, 0xa8, 0x1f, 0x81, 0x23, 0x87, 0xdc, 0xa8, 0x04,
+	0x07, 0xc4, 0x01, 0x50, 0xc2, 0xc7, 0xe0, 0x80, 0x3c, 0x7e, 0xde, 0xac, 0xd7, 0x6b, 0xef, 0x26,
+	0x54, 0x42, 0x9c, 0x76, 0x3c, 0xf3, 0xfe, 0xfc, 0x7e, 0x6f, 0xde, 0xbc, 0xf7, 0x16, 0xa6, 0x3c,
+	0x6e, 0x3c, 0x12, 0xc1, 0x8e, 0xeb, 0x3f, 0xe1, 0xbe, 0xc9, 0xf6, 0x56, 0xd9, 0xe3, 0xa6, 0xf0,
+	0xf7, 0x75, 0xcf, 0x77, 0x03, 0x97, 0x5e, 0x49, 0x9c, 0xea, 0x7b, 0xab, 0xc5, 0x25, 0xc3, 0x95,
+	0x0d, 0x57, 0xb2, 0x1a, 0x97, 0x22, 0x12, 0x65, 0x7b, 0xab, 0x35, 0x11, 0xf0, 0x55, 0xe6, 0x71,
+	0xcb, 0x76, 0x78, 0x60, 0xbb, 0x4e, 0xa4, 0x5d, 0x2c, 0xb5, 0xcb, 0xc6, 0x52, 0x86, 0x6b, 0xc7,
+	0xe7, 0x13, 0x96, 0x6b, 0xb9, 0x6a, 0xc9, 0xc2, 0x15, 0xee, 0x4e, 0x59, 0xae, 0x6b, 0xd5, 0x05,
+	0xe3, 0x9e, 0xcd, 0xb8, 0xe3, 0xb8, 0x81, 0x32, 0x29, 0x63, 0x9b, 0x29, 0xbc, 0x96, 0x70, 0x84,
+	0xb4, 0xf1, 0x5c, 0x9b, 0x00, 0xfa, 0x7e, 0x88, 0x6a, 0x9b, 0xfb, 0xbc, 0x21, 0x2b, 0xe2, 0x71,
+	0x53, 0xc8, 0x40, 0xdb, 0x84, 0xf1, 0xc4, 0xae, 0xf4, 0x5c, 0x47, 0x0a, 0xba, 0x02, 0xc3, 0x9e,
+	0xda, 0x29, 0x90, 0x19, 0x52, 0x1e, 0x5b, 0x2b, 0xe8, 0x9d, 0x7c, 0x75, 0xd4, 0x40, 0x39, 0xad,
+	0x00, 0x2f, 0xa1, 0xa1, 0xa6, 0x14, 0xf7, 0x02, 0x1e, 0x88, 0xd8, 0xc5, 0x43, 0x98, 0x4c, 0x9d,
+	0xa0, 0x9b, 0x5b, 0x30, 0xe6, 0x85, 0xbb, 0x55, 0x19, 0x6e, 0xa3, 0xaf, 0xa9, 0x6e, 0xbe, 0x5a,
+	0xaa, 0xe0, 0xb5, 0xd6, 0xda, 0x37, 0x04, 0x66, 0x95, 0xe9, 0x2d, 0x67, 0xa3, 0x6e, 0x5b, 0xbb,
+	0xc1, 0xb6, 0x52, 0x94, 0xeb, 0xfb, 0xf7, 0x84, 0x63, 0x0a, 0x1f, 0x11, 0xd0, 0x45, 0xb8, 0xec,
+	0xfa, 0x76, 0x78, 0x07, 0xf5, 0xaa, 0x54, 0x27, 0xca, 0xd5, 0xa5, 0xca, 0x8b, 0xf1, 0x76, 0x24,
+	0x4f, 0x37, 0x00, 0x4e, 0xef, 0xaa, 0x30, 0xa8, 0xe0, 0x2c, 0xe8, 0xd1, 0x65, 0xe9, 0xe1, 0x65,
+	0xe9, 0x51, 0x0e, 0xe0, 0x95, 0xe9, 0xdb, 0xdc, 0x8a, 0x69, 0x56, 0xda, 0x34, 0xb5, 0x23, 0x02,
+	0x73, 0xf9, 0xc0, 0x30, 0x00, 0x0f, 0xe0, 0xaa, 0xed, 0x54, 0x77, 0x94, 0x4c, 0x35, 0xa2, 0x1d,
+	0x86, 0xfc, 0x42, 0x79, 0x6c, 0x6d, 0x3e, 0x1d, 0x86, 0xa4, 0xb5, 0xbb, 0x4e, 0xe0, 0xef, 0xaf,
+	0x5f, 0x3c, 0xfa, 0x7d, 0x7a, 0xa0, 0x72, 0xd9, 0x4e, 0x3a, 0xa2, 0x9b, 0x5d, 0x98, 0x2c, 0xf6,
+	0x64, 0x12, 0xa1, 0x4a, 0x50, 0x79, 0x4a, 0x60, 0xa9, 0x3b, 0x95, 0x8a, 0xd8, 0x69, 0x3a, 0xe6,
+	0x9d, 0x5d, 0xee, 0x38, 0xa2, 0x1e, 0x87, 0x7a, 0x12, 0x46, 0x3c, 0xd7, 0x0f, 0xaa, 0xb6, 0x89,
+	0x21, 0x1e, 0x0e, 0x3f, 0xb7, 0x4c, 0x7a, 0x0d, 0xc0, 0x88, 0x44, 0xc3, 0xb3, 0x41, 0x75, 0x76,
+	0x09, 0x77, 0xb6, 0xcc, 0x8e, 0xc8, 0x5f, 0x38, 0x77, 0xe4, 0x7f, 0x22, 0xf0, 0x6a, 0x5f, 0x70,
+	0xff, 0x37, 0x17, 0x60, 0xc3, 0x34, 0x12, 0x0a, 0x84, 0xdf, 0x10, 0xa6, 0xcd, 0x03, 0x71, 0xdb,
+	0x30, 0xdc, 0xa6, 0x13, 0xc4, 0x41, 0x4f, 0xc6, 0x96, 0x74, 0xc6, 0xb6, 0x4b, 0xfa, 0x0f, 0x76,
+	0x4b, 0x7f, 0xed, 0x5b, 0x02, 0x33, 0xd9, 0xbe, 0x30, 0x62, 0x05, 0x18, 0xe1, 0xa6, 0xe9, 0x0b,
+	0x29, 0xd1, 0x53, 0xfc, 0x49, 0x2d, 0x18, 0xad, 0xf1, 0x3a, 0x77, 0x0c, 0x21, 0x0b, 0x83, 0x2a,
+	0x84, 0x2f, 0x27, 0x08, 0xc7, 0x54, 0xef, 0xb8, 0xb6, 0xb3, 0xbe, 0x12, 0x86, 0xed, 0xfb, 0x3f,
+	0xa6, 0xcb, 0x96, 0x1d, 0xec, 0x36, 0x6b, 0xba, 0xe1, 0x36, 0x18, 0x56, 0xc5, 0xe8, 0x67, 0x59,
+	0x9a, 0x8f, 0x58, 0xb0, 0xef, 0x09, 0xa9, 0x14, 0x64, 0xa5, 0x65, 0x5c, 0xfb, 0x08, 0x4b, 0x59,
+	0xc5, 0x6d, 0x06, 0x22, 0x2e, 0x65, 0x1d, 0x29, 0x44, 0xce, 0x9d, 0x42, 0x5f, 0x13, 0x18, 0x4f,
+	0x98, 0x47, 0xe2, 0x37, 0x61, 0xd8, 0x57, 0x3b, 0x98, 0x1f, 0x93, 0xe9, 0xfc, 0x50, 0x1a, 0x98,
+	0x11, 0x28, 0xfc, 0xfc, 0x12, 0x61, 0x11, 0xae, 0x9e, 0xc2, 0x8a, 0x49, 0x53, 0xb8, 0xe8, 0xf0,
+	0x86, 0xc0, 0xab, 0x50, 0x6b, 0x6d, 0xab, 0x3d, 0x3c, 0x2d, 0xf8, 0x37, 0x60, 0x48, 0x21, 0xc2,
+	0xc8, 0xf4, 0x40, 0x1f, 0xc9, 0x6a, 0x76, 0x1c, 0x0a, 0x61, 0x08, 0xdb, 0x0b, 0xfe, 0xed, 0x2b,
+	0x2f, 0xc2, 0xa8, 0x0c, 0x4d, 0x38, 0x86, 0x50, 0x6f, 0xfc, 0x62, 0xa5, 0xf5, 0xad, 0x3d, 0x84,
+	0x89, 0xa4, 0x2b, 0xc4, 0xfd, 0x16, 0x8c, 0xf8, 0xd1, 0x16, 0x22, 0x9f, 0x49, 0x23, 0xdf, 0x88,
+	0x96, 0xa8, 0x8a, 0x14, 0x62, 0x35, 0xed, 0x73, 0x02, 0x53, 0xed, 0xa6, 0xff, 0xfb, 0xfe, 0xf0,
+	0x94, 0xc0, 0xb5, 0x0c, 0x44, 0xc8, 0x7a, 0x1d, 0x46, 0x11, 0x7e, 0x9c, 0x6e, 0xfd, 0xd2, 0x6e,
+	0xe9, 0x3d, 0xb7, 0xcc, 0x5b, 0xfb, 0xf1, 0x05, 0x18, 0x52, 0x70, 0xe9, 0x27, 0x04, 0x86, 0xa3,
+	0xc6, 0x4f, 0xe7, 0xd2, 0x78, 0xd2, 0xf3, 0x45, 0x71, 0xbe, 0x87, 0x54, 0xe4, 0x4d, 0xbb, 0xfe,
+	0xe9, 0xcf, 0x7f, 0x7d, 0x35, 0x38, 0x4b, 0x5f, 0x61, 0x76, 0xcd, 0x60, 0xdc, 0xf3, 0x24, 0x4b,
+	0x8d, 0x33, 0xd1, 0xa0, 0x41, 0xbf, 0x24, 0x00, 0xa7, 0xf3, 0x00, 0x2d, 0x67, 0x3a, 0xe8, 0x98,
+	0x43, 0x8a, 0xd7, 0xfb, 0x90, 0x44, 0x38, 0xba, 0x82, 0x53, 0xa6, 0x0b, 0xb9, 0x70, 0x5a, 0x83,
+	0x0b, 0xfd, 0x8d, 0xc0, 0x64, 0x46, 0xab, 0xa7, 0x37, 0x33, 0xdc, 0xe6, 0xcf, 0x2c, 0xc5, 0xd7,
+	0xce, 0xaa, 0x86, 0xd0, 0xb7, 0x15, 0xf4, 0x77, 0xe8, 0xdb, 0x39, 0xd0, 0x53, 0x1d, 0x8f, 0xd5,
+	0xf6, 0x31, 0xf1, 0xd9, 0x41, 0xc7, 0x4b, 0x38, 0xa4, 0x7f, 0x13, 0x28, 0xe5, 0x77, 0x53, 0xfa,
+	0x46, 0xbf, 0x60, 0xbb, 0xcd, 0x0c, 0xc5, 0x5b, 0xe7, 0xd4, 0x46, 0xc6, 0x1f, 0x2b, 0xc6, 0x0f,
+	0xe8, 0x07, 0x67, 0x65, 0xec, 0x2b, 0x73, 0x55, 0x2c, 0x4f, 0xec, 0x00, 0x4b, 0xda, 0x21, 0x3b,
+	0x38, 0xad, 0x61, 0x87, 0xf4, 0x17, 0x02, 0xe3, 0x5d, 0xfa, 0x21, 0x5d, 0xcd, 0x44, 0x9d, 0xd5,
+	0xa7, 0x8b, 0x6b, 0x67, 0x51, 0x41, 0x76, 0xf7, 0x15, 0xbb, 0x6d, 0xfa, 0x5e, 0x2e, 0xbb, 0x53,
+	0xfd, 0x2a, 0x8f, 0x0c, 0x24, 0x48, 0x74, 0xb9, 0xd5, 0xf0, 0x25, 0x47, 0x0d, 0x2e, 0xf3, 0x25,
+	0x27, 0xda, 0x6b, 0x71, 0xbe, 0x87, 0xd4, 0x19, 0x5e, 0x32, 0x76, 0xc6, 0xcf, 0x08, 0x0c, 0x29,
+	0x6d, 0x3a, 0x9b, 0x67, 0x3b, 0x06, 0x30, 0x97, 0x2f, 0x84, 0xfe, 0x57, 0x94, 0xff, 0x25, 0x5a,
+	0xee, 0xe9, 0x9f, 0x1d, 0x84, 0xdd, 0xf2, 0x90, 0x7e, 0x47, 0x60, 0x04, 0x4b, 0x28, 0xcd, 0x24,
+	0x99, 0xe8, 0x7f, 0xc5, 0x85, 0x5e, 0x62, 0x08, 0xe6, 0x5d, 0x05, 0x66, 0x93, 0xde, 0xcd, 0x03,
+	0x13, 0xe9, 0xc8, 0x8c, 0xfc, 0x63, 0x07, 0x71, 0x87, 0x3c, 0xa4, 0x3f, 0x10, 0xb8, 0xd2, 0xd9,
+	0x31, 0xa8, 0x9e, 0x8f, 0x25, 0x55, 0x58, 0x58, 0xdf, 0xf2, 0x48, 0x62, 0x53, 0x91, 0xb8, 0x4d,
+	0xdf, 0xec, 0x87, 0x44, 0x4e, 0x21, 0x59, 0xf7, 0x8e, 0x8e, 0x4b, 0xe4, 0xd9, 0x71, 0x89, 0xfc,
+	0x79, 0x5c, 0x22, 0x5f, 0x9c, 0x94, 0x06, 0x9e, 0x9d, 0x94, 0x06, 0x7e, 0x3d, 0x29, 0x0d, 0x7c,
+	0x78, 0x3f, 0x3d, 0x04, 0xda, 0x35, 0x63, 0x59, 0xf9, 0x6a, 0xd8, 0xa6, 0x59, 0x17, 0x4f, 0xb8,
+	0x2f, 0xd0, 0xed, 0x32, 0xfa, 0x5d, 0x6e, 0x3b, 0xd9, 0x7b, 0xbd, 0x03, 0x93, 0x1a, 0x1c, 0x6b,
+	0xc3, 0xea, 0xaf, 0xef, 0x8d, 0x7f, 0x06, 0x00, 0x8f, 0xff, 0x19, 0xcb, 0xcc, 0x0f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries all parameters of the packetforward module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// PauseState queries which forwards are currently halted.
+	PauseState(ctx context.Context, in *QueryPauseStateRequest, opts ...grpc.CallOption) (*QueryPauseStateResponse, error)
+	// InFlightPacketsBySender queries the in-flight packets of an original
+	// sender.
+	InFlightPacketsBySender(ctx context.Context, in *QueryInFlightPacketsBySenderRequest, opts ...grpc.CallOption) (*QueryInFlightPacketsBySenderResponse, error)
+	// InFlightPacketsByRefundChannel queries the in-flight packets that are
+	// refunded over a channel.
+	InFlightPacketsByRefundChannel(ctx context.Context, in *QueryInFlightPacketsByRefundChannelRequest, opts ...grpc.CallOption) (*QueryInFlightPacketsByRefundChannelResponse, error)
+	// IntermediateAccount queries the intermediate account of an original sender
+	// and the funds held by it.
+	IntermediateAccount(ctx context.Context, in *QueryIntermediateAccountRequest, opts ...grpc.CallOption) (*QueryIntermediateAccountResponse, error)
+	// Routes queries the registered routes.
+	Routes(ctx context.Context, in *QueryRoutesRequest, opts ...grpc.CallOption) (*QueryRoutesResponse, error)
+	// Route queries a registered route by name.
+	Route(ctx context.Context, in *QueryRouteRequest, opts ...grpc.CallOption) (*QueryRouteResponse, error)
+	// Receipt queries the forward receipt of an original packet.
+	Receipt(ctx context.Context, in *QueryReceiptRequest, opts ...grpc.CallOption) (*QueryReceiptResponse, error)
+	// ReceiptsBySender queries the forward receipts of an original sender.
+	ReceiptsBySender(ctx context.Context, in *QueryReceiptsBySenderRequest, opts ...grpc.CallOption) (*QueryReceiptsBySenderResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/packetforward.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PauseState(ctx context.Context, in *QueryPauseStateRequest, opts ...grpc.CallOption) (*QueryPauseStateResponse, error) {
+	out := new(QueryPauseStateResponse)
+	err := c.cc.Invoke(ctx, "/packetforward.v1.Query/PauseState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InFlightPacketsBySender(ctx context.Context, in *QueryInFlightPacketsBySenderRequest, opts ...grpc.CallOption) (*QueryInFlightPacketsBySenderResponse, error) {
+	out := new(QueryInFlightPacketsBySenderResponse)
+	err := c.cc.Invoke(ctx, "/packetforward.v1.Query/InFlightPacketsBySender", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InFlightPacketsByRefundChannel(ctx context.Context, in *QueryInFlightPacketsByRefundChannelRequest, opts ...grpc.CallOption) (*QueryInFlightPacketsByRefundChannelResponse, error) {
+	out := new(QueryInFlightPacketsByRefundChannelResponse)
+	err := c.cc.Invoke(ctx, "/packetforward.v1.Query/InFlightPacketsByRefundChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IntermediateAccount(ctx context.Context, in *QueryIntermediateAccountRequest, opts ...grpc.CallOption) (*QueryIntermediateAccountResponse, error) {
+	out := new(QueryIntermediateAccountResponse)
+	err := c.cc.Invoke(ctx, "/packetforward.v1.Query/IntermediateAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Routes(ctx context.Context, in *QueryRoutesRequest, opts ...grpc.CallOption) (*QueryRoutesResponse, error) {
+	out := new(QueryRoutesResponse)
+	err := c.cc.Invoke(ctx, "/packetforward.v1.Query/Routes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Route(ctx context.Context, in *QueryRouteRequest, opts ...grpc.CallOption) (*QueryRouteResponse, error) {
+	out := new(QueryRouteResponse)
+	err := c.cc.Invoke(ctx, "/packetforward.v1.Query/Route", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Receipt(ctx context.Context, in *QueryReceiptRequest, opts ...grpc.CallOption) (*QueryReceiptResponse, error) {
+	out := new(QueryReceiptResponse)
+	err := c.cc.Invoke(ctx, "/packetforward.v1.Query/Receipt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReceiptsBySender(ctx context.Context, in *QueryReceiptsBySenderRequest, opts ...grpc.CallOption) (*QueryReceiptsBySenderResponse, error) {
+	out := new(QueryReceiptsBySenderResponse)
+	err := c.cc.Invoke(ctx, "/packetforward.v1.Query/ReceiptsBySender", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries all parameters of the packetforward module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// PauseState queries which forwards are currently halted.
+	PauseState(context.Context, *QueryPauseStateRequest) (*QueryPauseStateResponse, error)
+	// InFlightPacketsBySender queries the in-flight packets of an original
+	// sender.
+	InFlightPacketsBySender(context.Context, *QueryInFlightPacketsBySenderRequest) (*QueryInFlightPacketsBySenderResponse, error)
+	// InFlightPacketsByRefundChannel queries the in-flight packets that are
+	// refunded over a channel.
+	InFlightPacketsByRefundChannel(context.Context, *QueryInFlightPacketsByRefundChannelRequest) (*QueryInFlightPacketsByRefundChannelResponse, error)
+	// IntermediateAccount queries the intermediate account of an original sender
+	// and the funds held by it.
+	IntermediateAccount(context.Context, *QueryIntermediateAccountRequest) (*QueryIntermediateAccountResponse, error)
+	// Routes queries the registered routes.
+	Routes(context.Context, *QueryRoutesRequest) (*QueryRoutesResponse, error)
+	// Route queries a registered route by name.
+	Route(context.Context, *QueryRouteRequest) (*QueryRouteResponse, error)
+	// Receipt queries the forward receipt of an original packet.
+	Receipt(context.Context, *QueryReceiptRequest) (*QueryReceiptResponse, error)
+	// ReceiptsBySender queries the forward receipts of an original sender.
+	ReceiptsBySender(context.Context, *QueryReceiptsBySenderRequest) (*QueryReceiptsBySenderResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) PauseState(ctx context.Context, req *QueryPauseStateRequest) (*QueryPauseStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseState not implemented")
+}
+func (*UnimplementedQueryServer) InFlightPacketsBySender(ctx context.Context, req *QueryInFlightPacketsBySenderRequest) (*QueryInFlightPacketsBySenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InFlightPacketsBySender not implemented")
+}
+func (*UnimplementedQueryServer) InFlightPacketsByRefundChannel(ctx context.Context, req *QueryInFlightPacketsByRefundChannelRequest) (*QueryInFlightPacketsByRefundChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InFlightPacketsByRefundChannel not implemented")
+}
+func (*UnimplementedQueryServer) IntermediateAccount(ctx context.Context, req *QueryIntermediateAccountRequest) (*QueryIntermediateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntermediateAccount not implemented")
+}
+func (*UnimplementedQueryServer) Routes(ctx context.Context, req *QueryRoutesRequest) (*QueryRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Routes not implemented")
+}
+func (*UnimplementedQueryServer) Route(ctx context.Context, req *QueryRouteRequest) (*QueryRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Route not implemented")
+}
+func (*UnimplementedQueryServer) Receipt(ctx context.Context, req *QueryReceiptRequest) (*QueryReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Receipt not implemented")
+}
+func (*UnimplementedQueryServer) ReceiptsBySender(ctx context.Context, req *QueryReceiptsBySenderRequest) (*QueryReceiptsBySenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiptsBySender not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/packetforward.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PauseState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPauseStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PauseState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/packetforward.v1.Query/PauseState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PauseState(ctx, req.(*QueryPauseStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InFlightPacketsBySender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInFlightPacketsBySenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InFlightPacketsBySender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/packetforward.v1.Query/InFlightPacketsBySender",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InFlightPacketsBySender(ctx, req.(*QueryInFlightPacketsBySenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InFlightPacketsByRefundChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInFlightPacketsByRefundChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InFlightPacketsByRefundChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/packetforward.v1.Query/InFlightPacketsByRefundChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InFlightPacketsByRefundChannel(ctx, req.(*QueryInFlightPacketsByRefundChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IntermediateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIntermediateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IntermediateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/packetforward.v1.Query/IntermediateAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IntermediateAccount(ctx, req.(*QueryIntermediateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Routes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Routes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/packetforward.v1.Query/Routes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Routes(ctx, req.(*QueryRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Route_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Route(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/packetforward.v1.Query/Route",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Route(ctx, req.(*QueryRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Receipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Receipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/packetforward.v1.Query/Receipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Receipt(ctx, req.(*QueryReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReceiptsBySender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReceiptsBySenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReceiptsBySender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/packetforward.v1.Query/ReceiptsBySender",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReceiptsBySender(ctx, req.(*QueryReceiptsBySenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "packetforward.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "PauseState",
+			Handler:    _Query_PauseState_Handler,
+		},
+		{
+			MethodName: "InFlightPacketsBySender",
+			Handler:    _Query_InFlightPacketsBySender_Handler,
+		},
+		{
+			MethodName: "InFlightPacketsByRefundChannel",
+			Handler:    _Query_InFlightPacketsByRefundChannel_Handler,
+		},
+		{
+			MethodName: "IntermediateAccount",
+			Handler:    _Query_IntermediateAccount_Handler,
+		},
+		{
+			MethodName: "Routes",
+			Handler:    _Query_Routes_Handler,
+		},
+		{
+			MethodName: "Route",
+			Handler:    _Query_Route_Handler,
+		},
+		{
+			MethodName: "Receipt",
+			Handler:    _Query_Receipt_Handler,
+		},
+		{
+			MethodName: "ReceiptsBySender",
+			Handler:    _Query_ReceiptsBySender_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "packetforward/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PauseState != nil {
+		{
+			size, err := m.PauseState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInFlightPacketsBySenderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInFlightPacketsBySenderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInFlightPacketsBySenderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OriginalSender) > 0 {
+		i -= len(m.OriginalSender)
+		copy(dAtA[i:], m.OriginalSender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OriginalSender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInFlightPacketsBySenderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInFlightPacketsBySenderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInFlightPacketsBySenderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InFlightPackets) > 0 {
+		for iNdEx := len(m.InFlightPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InFlightPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInFlightPacketsByRefundChannelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInFlightPacketsByRefundChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInFlightPacketsByRefundChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInFlightPacketsByRefundChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInFlightPacketsByRefundChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInFlightPacketsByRefundChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InFlightPackets) > 0 {
+		for iNdEx := len(m.InFlightPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InFlightPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntermediateAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntermediateAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntermediateAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OriginalSender) > 0 {
+		i -= len(m.OriginalSender)
+		copy(dAtA[i:], m.OriginalSender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OriginalSender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntermediateAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntermediateAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntermediateAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoutesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoutesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRouteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReceiptRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReceiptRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReceiptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReceiptResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReceiptResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReceiptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Receipt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReceiptsBySenderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReceiptsBySenderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReceiptsBySenderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OriginalSender) > 0 {
+		i -= len(m.OriginalSender)
+		copy(dAtA[i:], m.OriginalSender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OriginalSender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReceiptsBySenderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReceiptsBySenderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReceiptsBySenderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Receipts) > 0 {
+		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Receipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPauseStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPauseStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PauseState != nil {
+		l = m.PauseState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInFlightPacketsBySenderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OriginalSender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInFlightPacketsBySenderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InFlightPackets) > 0 {
+		for _, e := range m.InFlightPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInFlightPacketsByRefundChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInFlightPacketsByRefundChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InFlightPackets) > 0 {
+		for _, e := range m.InFlightPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIntermediateAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OriginalSender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIntermediateAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRoutesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRoutesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRouteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Route.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReceiptRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryReceiptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Receipt.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReceiptsBySenderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OriginalSender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReceiptsBySenderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Receipts) > 0 {
+		for _, e := range m.Receipts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPauseStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPauseStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPauseStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPauseStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPauseStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPauseStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PauseState == nil {
+				m.PauseState = &PauseState{}
+			}
+			if err := m.PauseState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInFlightPacketsBySenderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInFlightPacketsBySenderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInFlightPacketsBySenderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInFlightPacketsBySenderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInFlightPacketsBySenderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInFlightPacketsBySenderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlightPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InFlightPackets = append(m.InFlightPackets, InFlightPacketEntry{})
+			if err := m.InFlightPackets[len(m.InFlightPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInFlightPacketsByRefundChannelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInFlightPacketsByRefundChannelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInFlightPacketsByRefundChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInFlightPacketsByRefundChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInFlightPacketsByRefundChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInFlightPacketsByRefundChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlightPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InFlightPackets = append(m.InFlightPackets, InFlightPacketEntry{})
+			if err := m.InFlightPackets[len(m.InFlightPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIntermediateAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIntermediateAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIntermediateAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIntermediateAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIntermediateAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIntermediateAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRoutesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRoutesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRoutesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRoutesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, Route{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRouteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRouteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReceiptRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReceiptRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReceiptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryReceiptResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReceiptResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReceiptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReceiptsBySenderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -343,12 +4071,80 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReceiptsBySenderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReceiptsBySenderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -370,7 +4166,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReceiptsBySenderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -393,15 +4189,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReceiptsBySenderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReceiptsBySenderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -428,10 +4224,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
+			m.Receipts = append(m.Receipts, ForwardReceipt{})
+			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// Validate performs basic validation of the forward receipt.
+func (r ForwardReceipt) Validate() error {
+	if r.OriginalSender == "" {
+		return fmt.Errorf("forward receipt original sender cannot be empty")
+	}
+	if err := host.PortIdentifierValidator(r.PortId); err != nil {
+		return fmt.Errorf("invalid forward receipt port: %w", err)
+	}
+	if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+		return fmt.Errorf("invalid forward receipt channel: %w", err)
+	}
+	if err := host.PortIdentifierValidator(r.ForwardPortId); err != nil {
+		return fmt.Errorf("invalid forward receipt forward port: %w", err)
+	}
+	if err := host.ChannelIdentifierValidator(r.ForwardChannelId); err != nil {
+		return fmt.Errorf("invalid forward receipt forward channel: %w", err)
+	}
+	if _, ok := ForwardOutcome_name[int32(r.Outcome)]; !ok || r.Outcome == ForwardOutcomeUnspecified {
+		return fmt.Errorf("invalid forward receipt outcome %d", r.Outcome)
+	}
+	if err := r.Fees.Validate(); err != nil {
+		return fmt.Errorf("invalid forward receipt fees: %w", err)
+	}
+	if r.Height < 0 {
+		return fmt.Errorf("forward receipt height cannot be negative")
+	}
+	return nil
+}
+
+// ValidateReceipts validates the forward receipts and asserts that there is at most one per original packet.
+func ValidateReceipts(receipts []ForwardReceipt) error {
+	seen := make(map[string]bool, len(receipts))
+	for _, receipt := range receipts {
+		if err := receipt.Validate(); err != nil {
+			return err
+		}
+
+		key := string(ReceiptKey(receipt.PortId, receipt.ChannelId, receipt.Sequence))
+		if seen[key] {
+			return fmt.Errorf("duplicate forward receipt of packet %s/%s/%d", receipt.PortId, receipt.ChannelId, receipt.Sequence)
+		}
+		seen[key] = true
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateReceipts(t *testing.T) {
+	receipt := types.ForwardReceipt{
+		OriginalSender:   "cosmos1wnlew8ss0sqclfalvj6jkcyvnwq79fd74qxxue",
+		PortId:           "transfer",
+		ChannelId:        "channel-0",
+		Sequence:         1,
+		ForwardPortId:    "transfer",
+		ForwardChannelId: "channel-1",
+		ForwardSequence:  1,
+		Outcome:          types.ForwardOutcomeRefunded,
+		Fees:             sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)),
+		Height:           1,
+	}
+	withReceipt := func(f func(r *types.ForwardReceipt)) types.ForwardReceipt {
+		r := receipt
+		f(&r)
+		return r
+	}
+
+	for _, tc := range []struct {
+		name     string
+		receipts []types.ForwardReceipt
+		expErr   bool
+	}{
+		{"empty", nil, false},
+		{"valid", []types.ForwardReceipt{receipt, withReceipt(func(r *types.ForwardReceipt) { r.Sequence = 2 })}, false},
+		{"duplicate", []types.ForwardReceipt{receipt, receipt}, true},
+		{"empty sender", []types.ForwardReceipt{withReceipt(func(r *types.ForwardReceipt) { r.OriginalSender = "" })}, true},
+		{"invalid channel", []types.ForwardReceipt{withReceipt(func(r *types.ForwardReceipt) { r.ChannelId = "" })}, true},
+		{"invalid forward port", []types.ForwardReceipt{withReceipt(func(r *types.ForwardReceipt) { r.ForwardPortId = "" })}, true},
+		{"unspecified outcome", []types.ForwardReceipt{withReceipt(func(r *types.ForwardReceipt) { r.Outcome = types.ForwardOutcomeUnspecified })}, true},
+		{"unknown outcome", []types.ForwardReceipt{withReceipt(func(r *types.ForwardReceipt) { r.Outcome = 42 })}, true},
+		{"invalid fees", []types.ForwardReceipt{withReceipt(func(r *types.ForwardReceipt) { r.Fees = sdk.Coins{sdk.NewInt64Coin("uatom", 0)} })}, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateReceipts(tc.receipts)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
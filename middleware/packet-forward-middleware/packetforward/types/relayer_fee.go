@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RelayerFeeMetadata defines the ICS-29 relayer fees for the next hop. The amounts are
+// denominated in the forwarded token and are paid out of the forwarded amount.
+type RelayerFeeMetadata struct {
+	RecvFee    string `json:"recv_fee,omitempty"`
+	AckFee     string `json:"ack_fee,omitempty"`
+	TimeoutFee string `json:"timeout_fee,omitempty"`
+}
+
+// Validate checks that the fee amounts are valid and that at least one fee is set.
+func (m *RelayerFeeMetadata) Validate() error {
+	_, err := m.RelayerFee(sdk.DefaultBondDenom)
+	return err
+}
+
+// RelayerFee returns the relayer fee with the amounts denominated in denom.
+func (m *RelayerFeeMetadata) RelayerFee(denom string) (RelayerFee, error) {
+	recvFee, err := parseRelayerFeeAmount("recv_fee", m.RecvFee)
+	if err != nil {
+		return RelayerFee{}, err
+	}
+	ackFee, err := parseRelayerFeeAmount("ack_fee", m.AckFee)
+	if err != nil {
+		return RelayerFee{}, err
+	}
+	timeoutFee, err := parseRelayerFeeAmount("timeout_fee", m.TimeoutFee)
+	if err != nil {
+		return RelayerFee{}, err
+	}
+
+	fee := RelayerFee{
+		RecvFee:    sdk.NewCoins(sdk.NewCoin(denom, recvFee)),
+		AckFee:     sdk.NewCoins(sdk.NewCoin(denom, ackFee)),
+		TimeoutFee: sdk.NewCoins(sdk.NewCoin(denom, timeoutFee)),
+	}
+	if fee.Total().IsZero() {
+		return RelayerFee{}, fmt.Errorf("relayer fee cannot be zero")
+	}
+
+	return fee, nil
+}
+
+func parseRelayerFeeAmount(name, amount string) (sdk.Int, error) {
+	if amount == "" {
+		return sdk.ZeroInt(), nil
+	}
+	amt, ok := sdk.NewIntFromString(amount)
+	if !ok || amt.IsNegative() {
+		return sdk.Int{}, fmt.Errorf("invalid relayer %s: %s", name, amount)
+	}
+	return amt, nil
+}
+
+// Total returns the sum of all fees, which is escrowed when the forwarded packet is sent.
+func (f RelayerFee) Total() sdk.Coins {
+	return f.RecvFee.Add(f.AckFee...).Add(f.TimeoutFee...)
+}
+
+// Unused returns the fees that are refunded by the fee middleware once the forwarded packet
+// is acknowledged or timed out.
+func (f RelayerFee) Unused(timedOut bool) sdk.Coins {
+	if timedOut {
+		return f.RecvFee.Add(f.AckFee...)
+	}
+	return f.TimeoutFee
+}
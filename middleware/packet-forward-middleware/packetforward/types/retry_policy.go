@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// ackErrorCodeRegexp matches the ABCI code of an error acknowledgement written by ibc-go, which only keeps the
+// code of the error so that the acknowledgement is deterministic. The codespace of the error is not kept.
+var ackErrorCodeRegexp = regexp.MustCompile(`^ABCI code: (\d+): `)
+
+// RetryPolicy defines which error acknowledgements of the forwarded packet are retried, and where retries are
+// sent. Error acknowledgements are retried like timeouts, sharing the retries of the forward.
+type RetryPolicy struct {
+	// ErrorCodes are the ABCI codes of the error acknowledgements that are retried, such as the code of a rate
+	// limit error of the next chain. Error acknowledgements do not carry the codespace of the error, so a code
+	// matches the errors of every module of the next chain that use it.
+	ErrorCodes []uint32 `json:"error_codes,omitempty"`
+	// AlternateChannel optionally is the channel, on the port of the forward, that retries of error
+	// acknowledgements are sent over instead of the channel of the forward. Retries of timeouts are sent over the
+	// channel of the forward.
+	AlternateChannel string `json:"alternate_channel,omitempty"`
+}
+
+// Validate checks that the alternate channel is a valid identifier.
+func (p *RetryPolicy) Validate() error {
+	if p.AlternateChannel != "" {
+		if err := host.ChannelIdentifierValidator(p.AlternateChannel); err != nil {
+			return fmt.Errorf("invalid alternate channel: %w", err)
+		}
+	}
+	return nil
+}
+
+// ParseAckErrorCode returns the ABCI code of the error of an error acknowledgement. It returns false if the
+// error does not include an ABCI code, such as errors of the packetforward middleware.
+func ParseAckErrorCode(ackErr string) (uint32, bool) {
+	matches := ackErrorCodeRegexp.FindStringSubmatch(ackErr)
+	if matches == nil {
+		return 0, false
+	}
+
+	code, err := strconv.ParseUint(matches[1], 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return uint32(code), true
+}
+
+// IsRetriableError returns true if the error of an error acknowledgement of the forwarded packet has one of the
+// retriable error codes.
+func (m InFlightPacket) IsRetriableError(ackErr string) bool {
+	code, ok := ParseAckErrorCode(ackErr)
+	if !ok {
+		return false
+	}
+
+	for _, retriable := range m.RetriableErrorCodes {
+		if code == retriable {
+			return true
+		}
+	}
+	return false
+}
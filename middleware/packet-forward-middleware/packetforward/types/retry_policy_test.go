@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	"github.com/stretchr/testify/require"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+)
+
+func TestParseAckErrorCode(t *testing.T) {
+	ack := channeltypes.NewErrorAcknowledgement(transfertypes.ErrReceiveDisabled)
+	code, ok := types.ParseAckErrorCode(ack.GetError())
+	require.True(t, ok)
+	require.Equal(t, uint32(8), code)
+
+	for _, ackErr := range []string{
+		"",
+		"packet-forward-middleware error: giving up on packet",
+		"ABCI code: 99999999999: error handling packet",
+		"ABCI code: abc: error handling packet",
+	} {
+		_, ok := types.ParseAckErrorCode(ackErr)
+		require.False(t, ok, ackErr)
+	}
+}
+
+func TestInFlightPacketIsRetriableError(t *testing.T) {
+	inFlightPacket := types.InFlightPacket{RetriableErrorCodes: []uint32{5, 8}}
+	receiveDisabled := channeltypes.NewErrorAcknowledgement(transfertypes.ErrReceiveDisabled)
+	sendDisabled := channeltypes.NewErrorAcknowledgement(transfertypes.ErrSendDisabled)
+
+	require.True(t, inFlightPacket.IsRetriableError(receiveDisabled.GetError()))
+	require.False(t, inFlightPacket.IsRetriableError(sendDisabled.GetError()))
+	require.False(t, types.InFlightPacket{}.IsRetriableError(receiveDisabled.GetError()))
+}
+
+func TestRetryPolicyValidate(t *testing.T) {
+	require.NoError(t, (&types.RetryPolicy{ErrorCodes: []uint32{8}}).Validate())
+	require.NoError(t, (&types.RetryPolicy{AlternateChannel: "channel-1"}).Validate())
+	require.Error(t, (&types.RetryPolicy{AlternateChannel: "invalid/channel"}).Validate())
+}
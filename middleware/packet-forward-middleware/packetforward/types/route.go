@@ -0,0 +1,172 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// IntermediateReceiver is the receiver set on the intermediate hops of an expanded route. It is not a valid
+// bech32 address, so a hop to a chain without the packet-forward-middleware fails and is refunded.
+const IntermediateReceiver = "pfm"
+
+// MaxRouteNameLength is the maximum length of a route name.
+const MaxRouteNameLength = 64
+
+var isValidRouteName = regexp.MustCompile(`^[a-zA-Z0-9._\-]+$`).MatchString
+
+// NewRoute creates a new Route instance.
+func NewRoute(name string, hops ...RouteHop) Route {
+	return Route{
+		Name: name,
+		Hops: hops,
+	}
+}
+
+// NewRouteHop creates a new RouteHop instance.
+func NewRouteHop(portID, channelID string) RouteHop {
+	return RouteHop{
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+}
+
+// Validate performs basic validation of the route.
+func (r Route) Validate() error {
+	if err := validateRouteName(r.Name); err != nil {
+		return err
+	}
+	if len(r.Hops) == 0 {
+		return fmt.Errorf("route %s must have at least one hop", r.Name)
+	}
+	for i, hop := range r.Hops {
+		if err := host.PortIdentifierValidator(hop.PortId); err != nil {
+			return fmt.Errorf("invalid port of hop %d of route %s: %w", i, r.Name, err)
+		}
+		if err := host.ChannelIdentifierValidator(hop.ChannelId); err != nil {
+			return fmt.Errorf("invalid channel of hop %d of route %s: %w", i, r.Name, err)
+		}
+	}
+	return nil
+}
+
+// ValidateRoutes validates the routes and asserts that they are sorted by name and unique.
+func ValidateRoutes(routes []Route) error {
+	for i, route := range routes {
+		if err := route.Validate(); err != nil {
+			return err
+		}
+		if i > 0 && routes[i-1].Name >= route.Name {
+			return fmt.Errorf("routes must be sorted by name and unique")
+		}
+	}
+	return nil
+}
+
+func validateRouteName(name string) error {
+	if name == "" {
+		return fmt.Errorf("route name cannot be empty")
+	}
+	if len(name) > MaxRouteNameLength {
+		return fmt.Errorf("route name %s is longer than %d characters", name, MaxRouteNameLength)
+	}
+	if !isValidRouteName(name) {
+		return fmt.Errorf("route name %s may only contain alphanumeric characters, '.', '_' and '-'", name)
+	}
+	return nil
+}
+
+// ExpandRoute replaces the route of the forward metadata with the first hop of the route, and nests the
+// remaining hops in next. The receiver becomes the receiver of the last hop, and the original next is
+// passed on from the last hop. The timeout and retries apply to every hop.
+func (m *ForwardMetadata) ExpandRoute(route Route) error {
+	if m.Port != "" || m.Channel != "" {
+		return fmt.Errorf("route %s cannot be combined with port or channel", route.Name)
+	}
+	if err := m.expandHops(route.Hops); err != nil {
+		return fmt.Errorf("failed to expand route %s: %w", route.Name, err)
+	}
+
+	m.Route = ""
+	return nil
+}
+
+// ExpandUnwind replaces the unwind flag of the forward metadata with the hops that return a token with the
+// given denom trace to its origin chain, in the same way as ExpandRoute.
+func (m *ForwardMetadata) ExpandUnwind(denomTrace transfertypes.DenomTrace) error {
+	if m.Port != "" || m.Channel != "" {
+		return fmt.Errorf("unwind cannot be combined with port or channel")
+	}
+
+	hops, err := UnwindHops(denomTrace)
+	if err != nil {
+		return err
+	}
+	if err := m.expandHops(hops); err != nil {
+		return fmt.Errorf("failed to expand unwind of %s: %w", denomTrace.GetFullDenomPath(), err)
+	}
+
+	m.Unwind = false
+	return nil
+}
+
+// UnwindHops returns the hops that return a token with the given denom trace to its origin chain, starting on
+// the chain the denom trace is from.
+func UnwindHops(denomTrace transfertypes.DenomTrace) ([]RouteHop, error) {
+	if denomTrace.Path == "" {
+		return nil, fmt.Errorf("denom %s is native to this chain and cannot be unwound", denomTrace.BaseDenom)
+	}
+
+	identifiers := strings.Split(denomTrace.Path, "/")
+	if len(identifiers)%2 != 0 {
+		return nil, fmt.Errorf("invalid denom trace path %s", denomTrace.Path)
+	}
+
+	hops := make([]RouteHop, 0, len(identifiers)/2)
+	for i := 0; i < len(identifiers); i += 2 {
+		hops = append(hops, NewRouteHop(identifiers[i], identifiers[i+1]))
+	}
+	return hops, nil
+}
+
+// expandHops sets the first hop on the forward metadata and nests the remaining hops in next.
+func (m *ForwardMetadata) expandHops(hops []RouteHop) error {
+	if len(hops) == 0 {
+		return fmt.Errorf("no hops")
+	}
+
+	receiver := m.Receiver
+	next := m.Next
+	for i := len(hops) - 1; i > 0; i-- {
+		hop := &ForwardMetadata{
+			Receiver: receiver,
+			Port:     hops[i].PortId,
+			Channel:  hops[i].ChannelId,
+			Timeout:  m.Timeout,
+			Retries:  m.Retries,
+			Next:     next,
+		}
+
+		bz, err := json.Marshal(PacketMetadata{Forward: hop})
+		if err != nil {
+			return err
+		}
+
+		next = &JSONObject{}
+		if err := next.UnmarshalJSON(bz); err != nil {
+			return err
+		}
+		receiver = IntermediateReceiver
+	}
+
+	m.Receiver = receiver
+	m.Port = hops[0].PortId
+	m.Channel = hops[0].ChannelId
+	m.Next = next
+
+	return nil
+}
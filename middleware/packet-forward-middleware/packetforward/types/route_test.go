@@ -0,0 +1,100 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	"github.com/stretchr/testify/require"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+)
+
+func TestRouteValidate(t *testing.T) {
+	hop := types.NewRouteHop("transfer", "channel-0")
+
+	for _, tc := range []struct {
+		name    string
+		route   types.Route
+		expPass bool
+	}{
+		{"valid", types.NewRoute("osmosis-via-hub", hop, types.NewRouteHop("transfer", "channel-141")), true},
+		{"empty name", types.NewRoute("", hop), false},
+		{"invalid name", types.NewRoute("osmosis/hub", hop), false},
+		{"no hops", types.NewRoute("osmosis"), false},
+		{"invalid port", types.NewRoute("osmosis", types.NewRouteHop("", "channel-0")), false},
+		{"invalid channel", types.NewRoute("osmosis", types.NewRouteHop("transfer", "chan")), false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.route.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	require.NoError(t, types.ValidateRoutes([]types.Route{types.NewRoute("a", hop), types.NewRoute("b", hop)}))
+	require.Error(t, types.ValidateRoutes([]types.Route{types.NewRoute("b", hop), types.NewRoute("a", hop)}))
+	require.Error(t, types.ValidateRoutes([]types.Route{types.NewRoute("a", hop), types.NewRoute("a", hop)}))
+}
+
+func TestForwardMetadataExpandRoute(t *testing.T) {
+	const memo = `{"forward":{"receiver":"osmo1receiver","route":"osmosis-via-hub","timeout":"10m","next":{"wasm":{"contract":"osmo1contract"}}}}`
+	route := types.NewRoute("osmosis-via-hub",
+		types.NewRouteHop("transfer", "channel-0"),
+		types.NewRouteHop("transfer", "channel-141"),
+		types.NewRouteHop("transfer", "channel-3"),
+	)
+
+	var packetMetadata types.PacketMetadata
+	require.NoError(t, json.Unmarshal([]byte(memo), &packetMetadata))
+
+	metadata := packetMetadata.Forward
+	require.NoError(t, metadata.ExpandRoute(route))
+	require.NoError(t, metadata.Validate())
+	require.Equal(t, types.IntermediateReceiver, metadata.Receiver)
+	require.Equal(t, "transfer", metadata.Port)
+	require.Equal(t, "channel-0", metadata.Channel)
+	require.Empty(t, metadata.Route)
+
+	nextBz, err := json.Marshal(metadata.Next)
+	require.NoError(t, err)
+	require.Equal(t,
+		`{"forward":{"receiver":"pfm","port":"transfer","channel":"channel-141","timeout":600000000000,`+
+			`"next":{"forward":{"receiver":"osmo1receiver","port":"transfer","channel":"channel-3","timeout":600000000000,`+
+			`"next":{"wasm":{"contract":"osmo1contract"}}}}}}`,
+		string(nextBz),
+	)
+}
+
+func TestForwardMetadataExpandRouteSingleHop(t *testing.T) {
+	metadata := &types.ForwardMetadata{Receiver: "osmo1receiver", Route: "osmosis"}
+	require.NoError(t, metadata.ExpandRoute(types.NewRoute("osmosis", types.NewRouteHop("transfer", "channel-0"))))
+	require.Equal(t, "osmo1receiver", metadata.Receiver)
+	require.Equal(t, "channel-0", metadata.Channel)
+	require.Nil(t, metadata.Next)
+
+	metadata = &types.ForwardMetadata{Receiver: "osmo1receiver", Channel: "channel-1", Route: "osmosis"}
+	require.Error(t, metadata.ExpandRoute(types.NewRoute("osmosis", types.NewRouteHop("transfer", "channel-0"))))
+}
+
+func TestForwardMetadataExpandUnwind(t *testing.T) {
+	metadata := &types.ForwardMetadata{Receiver: "cosmos1receiver", Unwind: true}
+	denomTrace := transfertypes.ParseDenomTrace("transfer/channel-1/transfer/channel-5/uatom")
+
+	require.NoError(t, metadata.ExpandUnwind(denomTrace))
+	require.NoError(t, metadata.Validate())
+	require.False(t, metadata.Unwind)
+	require.Equal(t, types.IntermediateReceiver, metadata.Receiver)
+	require.Equal(t, "channel-1", metadata.Channel)
+
+	nextBz, err := json.Marshal(metadata.Next)
+	require.NoError(t, err)
+	require.Equal(t, `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-5"}}`, string(nextBz))
+
+	// native denoms have nothing to unwind.
+	metadata = &types.ForwardMetadata{Receiver: "cosmos1receiver", Unwind: true}
+	require.Error(t, metadata.ExpandUnwind(transfertypes.ParseDenomTrace("uatom")))
+}
@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -129,37 +131,505 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetForwardingPaused is the Msg/SetForwardingPaused request type.
+type MsgSetForwardingPaused struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// paused halts new forwards when true and resumes them when false.
+	Paused bool `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	// channel_ids restricts the update to the given channels.
+	ChannelIds []string `protobuf:"bytes,3,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
+	// denoms restricts the update to the given denoms.
+	//
+	// NOTE: If neither channel_ids nor denoms are supplied, the update applies
+	// globally.
+	Denoms []string `protobuf:"bytes,4,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *MsgSetForwardingPaused) Reset()         { *m = MsgSetForwardingPaused{} }
+func (m *MsgSetForwardingPaused) String() string { return proto.CompactTextString(m) }
+func (*MsgSetForwardingPaused) ProtoMessage()    {}
+func (*MsgSetForwardingPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6309e74559641db6, []int{2}
+}
+func (m *MsgSetForwardingPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetForwardingPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetForwardingPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetForwardingPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetForwardingPaused.Merge(m, src)
+}
+func (m *MsgSetForwardingPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetForwardingPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetForwardingPaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetForwardingPaused proto.InternalMessageInfo
+
+func (m *MsgSetForwardingPaused) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetForwardingPaused) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *MsgSetForwardingPaused) GetChannelIds() []string {
+	if m != nil {
+		return m.ChannelIds
+	}
+	return nil
+}
+
+func (m *MsgSetForwardingPaused) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+// MsgSetForwardingPausedResponse defines the response structure for executing a
+// MsgSetForwardingPaused message.
+type MsgSetForwardingPausedResponse struct {
+}
+
+func (m *MsgSetForwardingPausedResponse) Reset()         { *m = MsgSetForwardingPausedResponse{} }
+func (m *MsgSetForwardingPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetForwardingPausedResponse) ProtoMessage()    {}
+func (*MsgSetForwardingPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6309e74559641db6, []int{3}
+}
+func (m *MsgSetForwardingPausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetForwardingPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetForwardingPausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetForwardingPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetForwardingPausedResponse.Merge(m, src)
+}
+func (m *MsgSetForwardingPausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetForwardingPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetForwardingPausedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetForwardingPausedResponse proto.InternalMessageInfo
+
+// MsgSweepIntermediateAccount is the Msg/SweepIntermediateAccount request type.
+// The intermediate account is derived from the channel and the original
+// sender, so any account may sign it.
+type MsgSweepIntermediateAccount struct {
+	// signer is the address of the account sweeping the intermediate account,
+	// which must be the account of the original sender on this chain or the
+	// governance account.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// channel_id is the channel on this chain the funds were received on.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// original_sender is the sender of the original packet on the source chain.
+	OriginalSender string `protobuf:"bytes,3,opt,name=original_sender,json=originalSender,proto3" json:"original_sender,omitempty"`
+	// to_recoverable_account moves the funds to the account of the original
+	// sender on this chain instead of transferring them back over channel_id.
+	ToRecoverableAccount bool `protobuf:"varint,4,opt,name=to_recoverable_account,json=toRecoverableAccount,proto3" json:"to_recoverable_account,omitempty"`
+	// port_id is the port on this chain the funds were received on.
+	PortId string `protobuf:"bytes,5,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+}
+
+func (m *MsgSweepIntermediateAccount) Reset()         { *m = MsgSweepIntermediateAccount{} }
+func (m *MsgSweepIntermediateAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgSweepIntermediateAccount) ProtoMessage()    {}
+func (*MsgSweepIntermediateAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6309e74559641db6, []int{4}
+}
+func (m *MsgSweepIntermediateAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSweepIntermediateAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSweepIntermediateAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSweepIntermediateAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSweepIntermediateAccount.Merge(m, src)
+}
+func (m *MsgSweepIntermediateAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSweepIntermediateAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSweepIntermediateAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSweepIntermediateAccount proto.InternalMessageInfo
+
+func (m *MsgSweepIntermediateAccount) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgSweepIntermediateAccount) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *MsgSweepIntermediateAccount) GetOriginalSender() string {
+	if m != nil {
+		return m.OriginalSender
+	}
+	return ""
+}
+
+func (m *MsgSweepIntermediateAccount) GetToRecoverableAccount() bool {
+	if m != nil {
+		return m.ToRecoverableAccount
+	}
+	return false
+}
+
+func (m *MsgSweepIntermediateAccount) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+// MsgSweepIntermediateAccountResponse defines the response structure for
+// executing a MsgSweepIntermediateAccount message.
+type MsgSweepIntermediateAccountResponse struct {
+	// swept are the funds moved out of the intermediate account.
+	Swept github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=swept,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"swept"`
+}
+
+func (m *MsgSweepIntermediateAccountResponse) Reset()         { *m = MsgSweepIntermediateAccountResponse{} }
+func (m *MsgSweepIntermediateAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSweepIntermediateAccountResponse) ProtoMessage()    {}
+func (*MsgSweepIntermediateAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6309e74559641db6, []int{5}
+}
+func (m *MsgSweepIntermediateAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSweepIntermediateAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSweepIntermediateAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSweepIntermediateAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSweepIntermediateAccountResponse.Merge(m, src)
+}
+func (m *MsgSweepIntermediateAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSweepIntermediateAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSweepIntermediateAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSweepIntermediateAccountResponse proto.InternalMessageInfo
+
+func (m *MsgSweepIntermediateAccountResponse) GetSwept() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Swept
+	}
+	return nil
+}
+
+// MsgSetRoute is the Msg/SetRoute request type.
+type MsgSetRoute struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// route is the route to register.
+	Route Route `protobuf:"bytes,2,opt,name=route,proto3" json:"route"`
+}
+
+func (m *MsgSetRoute) Reset()         { *m = MsgSetRoute{} }
+func (m *MsgSetRoute) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRoute) ProtoMessage()    {}
+func (*MsgSetRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6309e74559641db6, []int{6}
+}
+func (m *MsgSetRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRoute.Merge(m, src)
+}
+func (m *MsgSetRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRoute proto.InternalMessageInfo
+
+func (m *MsgSetRoute) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetRoute) GetRoute() Route {
+	if m != nil {
+		return m.Route
+	}
+	return Route{}
+}
+
+// MsgSetRouteResponse defines the response structure for executing a
+// MsgSetRoute message.
+type MsgSetRouteResponse struct {
+}
+
+func (m *MsgSetRouteResponse) Reset()         { *m = MsgSetRouteResponse{} }
+func (m *MsgSetRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRouteResponse) ProtoMessage()    {}
+func (*MsgSetRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6309e74559641db6, []int{7}
+}
+func (m *MsgSetRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRouteResponse.Merge(m, src)
+}
+func (m *MsgSetRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRouteResponse proto.InternalMessageInfo
+
+// MsgRemoveRoute is the Msg/RemoveRoute request type.
+type MsgRemoveRoute struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// name is the name of the route to remove.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *MsgRemoveRoute) Reset()         { *m = MsgRemoveRoute{} }
+func (m *MsgRemoveRoute) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRoute) ProtoMessage()    {}
+func (*MsgRemoveRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6309e74559641db6, []int{8}
+}
+func (m *MsgRemoveRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRoute.Merge(m, src)
+}
+func (m *MsgRemoveRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveRoute proto.InternalMessageInfo
+
+func (m *MsgRemoveRoute) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveRoute) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// MsgRemoveRouteResponse defines the response structure for executing a
+// MsgRemoveRoute message.
+type MsgRemoveRouteResponse struct {
+}
+
+func (m *MsgRemoveRouteResponse) Reset()         { *m = MsgRemoveRouteResponse{} }
+func (m *MsgRemoveRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRouteResponse) ProtoMessage()    {}
+func (*MsgRemoveRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6309e74559641db6, []int{9}
+}
+func (m *MsgRemoveRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRouteResponse.Merge(m, src)
+}
+func (m *MsgRemoveRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveRouteResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "packetforward.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "packetforward.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetForwardingPaused)(nil), "packetforward.v1.MsgSetForwardingPaused")
+	proto.RegisterType((*MsgSetForwardingPausedResponse)(nil), "packetforward.v1.MsgSetForwardingPausedResponse")
+	proto.RegisterType((*MsgSweepIntermediateAccount)(nil), "packetforward.v1.MsgSweepIntermediateAccount")
+	proto.RegisterType((*MsgSweepIntermediateAccountResponse)(nil), "packetforward.v1.MsgSweepIntermediateAccountResponse")
+	proto.RegisterType((*MsgSetRoute)(nil), "packetforward.v1.MsgSetRoute")
+	proto.RegisterType((*MsgSetRouteResponse)(nil), "packetforward.v1.MsgSetRouteResponse")
+	proto.RegisterType((*MsgRemoveRoute)(nil), "packetforward.v1.MsgRemoveRoute")
+	proto.RegisterType((*MsgRemoveRouteResponse)(nil), "packetforward.v1.MsgRemoveRouteResponse")
 }
 
 func init() { proto.RegisterFile("packetforward/v1/tx.proto", fileDescriptor_6309e74559641db6) }
 
 var fileDescriptor_6309e74559641db6 = []byte{
-	// 340 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2c, 0x48, 0x4c, 0xce,
-	0x4e, 0x2d, 0x49, 0xcb, 0x2f, 0x2a, 0x4f, 0x2c, 0x4a, 0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x40, 0x91, 0xd2, 0x2b, 0x33, 0x94, 0x12, 0x4f, 0xce,
-	0x2f, 0xce, 0xcd, 0x2f, 0xd6, 0xcf, 0x2d, 0x4e, 0x07, 0xa9, 0xcc, 0x2d, 0x4e, 0x87, 0x28, 0x95,
-	0x92, 0xc3, 0x30, 0x25, 0x3d, 0x35, 0x2f, 0xb5, 0x38, 0xb3, 0x18, 0x2a, 0x2f, 0x92, 0x9e, 0x9f,
-	0x9e, 0x0f, 0x66, 0xea, 0x83, 0x58, 0x50, 0x51, 0x49, 0x88, 0x71, 0xf1, 0x10, 0x09, 0x08, 0x07,
-	0x22, 0xa5, 0x34, 0x91, 0x91, 0x8b, 0xdf, 0xb7, 0x38, 0x3d, 0xb4, 0x20, 0x25, 0xb1, 0x24, 0x35,
-	0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0xc8, 0x8c, 0x8b, 0x33, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28,
-	0xb3, 0xa4, 0x52, 0x82, 0x51, 0x81, 0x51, 0x83, 0xd3, 0x49, 0xe2, 0xd2, 0x16, 0x5d, 0x11, 0xa8,
-	0x46, 0xc7, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0xe2, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0xf4, 0x20, 0x84,
-	0x52, 0x21, 0x33, 0x2e, 0xb6, 0x02, 0xb0, 0x09, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x12,
-	0x7a, 0xe8, 0x1e, 0xd3, 0x83, 0xd8, 0xe0, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0xb5,
-	0x15, 0x5f, 0xd3, 0xf3, 0x0d, 0x5a, 0x08, 0x73, 0x94, 0x24, 0xb9, 0xc4, 0xd1, 0x9c, 0x14, 0x94,
-	0x5a, 0x5c, 0x90, 0x9f, 0x57, 0x9c, 0x6a, 0x94, 0xcc, 0xc5, 0xec, 0x5b, 0x9c, 0x2e, 0x14, 0xc3,
-	0xc5, 0x83, 0xe2, 0x62, 0x45, 0x4c, 0x9b, 0xd0, 0x4c, 0x90, 0xd2, 0x24, 0xa8, 0x04, 0x66, 0x89,
-	0x53, 0xc1, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1,
-	0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x85, 0xa5, 0x67, 0x96,
-	0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0x42, 0x83, 0x51, 0x3f, 0x33, 0x29, 0x59, 0x37, 0xb1,
-	0xa0, 0xa0, 0x58, 0x3f, 0x37, 0x33, 0x25, 0x25, 0x27, 0xb5, 0x3c, 0xb1, 0x28, 0x55, 0x1f, 0x62,
-	0x93, 0x2e, 0xd4, 0x2a, 0x5d, 0x24, 0x99, 0x32, 0x73, 0x7d, 0xd4, 0x18, 0x2c, 0xa9, 0x2c, 0x48,
-	0x2d, 0x4e, 0x62, 0x03, 0x47, 0x86, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xb5, 0xf5, 0xf2, 0x80,
-	0x25, 0x02, 0x00, 0x00,
+	// 763 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x41, 0x6f, 0xd3, 0x48,
+	0x14, 0x8e, 0x37, 0x69, 0xb6, 0x99, 0xac, 0xda, 0x95, 0xdb, 0x4d, 0x9c, 0xac, 0xea, 0x66, 0xb3,
+	0x5a, 0x6d, 0x40, 0x8a, 0xdd, 0xb4, 0x50, 0xa4, 0xde, 0x1a, 0x24, 0xa4, 0x1e, 0x22, 0x55, 0xae,
+	0x40, 0x02, 0x21, 0x45, 0x13, 0xfb, 0xe1, 0x5a, 0x8d, 0x3d, 0x66, 0x66, 0x92, 0xd0, 0x1b, 0xe2,
+	0x80, 0x10, 0x27, 0xf8, 0x1b, 0x9c, 0x40, 0xe2, 0x47, 0xf4, 0x58, 0x71, 0xe2, 0x04, 0xa8, 0x3d,
+	0xf0, 0x03, 0xf8, 0x03, 0xc8, 0xe3, 0x89, 0x93, 0x34, 0x69, 0x0b, 0xe5, 0x94, 0x99, 0xf9, 0xbe,
+	0xf7, 0xbd, 0xef, 0x8d, 0xdf, 0xcb, 0xa0, 0x52, 0x88, 0xed, 0x03, 0xe0, 0x8f, 0x08, 0x1d, 0x60,
+	0xea, 0x98, 0xfd, 0x86, 0xc9, 0x9f, 0x18, 0x21, 0x25, 0x9c, 0xa8, 0x7f, 0x4e, 0x40, 0x46, 0xbf,
+	0x51, 0xd6, 0x6d, 0xc2, 0x7c, 0xc2, 0xcc, 0x0e, 0x66, 0x60, 0xf6, 0x1b, 0x1d, 0xe0, 0xb8, 0x61,
+	0xda, 0xc4, 0x0b, 0xe2, 0x88, 0x72, 0x51, 0xe2, 0x3e, 0x73, 0x23, 0x25, 0x9f, 0xb9, 0x12, 0xd0,
+	0xa7, 0xb2, 0xb8, 0x10, 0x00, 0xf3, 0x98, 0xc4, 0x97, 0x5d, 0xe2, 0x12, 0xb1, 0x34, 0xa3, 0x95,
+	0x3c, 0x2d, 0xc5, 0x72, 0xed, 0x18, 0x88, 0x37, 0x31, 0x54, 0x7d, 0xad, 0xa0, 0xc5, 0x16, 0x73,
+	0xef, 0x86, 0x0e, 0xe6, 0xb0, 0x8b, 0x29, 0xf6, 0x99, 0xba, 0x89, 0x72, 0xb8, 0xc7, 0xf7, 0x09,
+	0xf5, 0xf8, 0xa1, 0xa6, 0x54, 0x94, 0x5a, 0xae, 0xa9, 0x7d, 0x78, 0x5f, 0x5f, 0x96, 0x81, 0xdb,
+	0x8e, 0x43, 0x81, 0xb1, 0x3d, 0x4e, 0xbd, 0xc0, 0xb5, 0x46, 0x54, 0x75, 0x13, 0x65, 0x43, 0xa1,
+	0xa0, 0xfd, 0x56, 0x51, 0x6a, 0xf9, 0x75, 0xcd, 0x38, 0x5b, 0xb8, 0x11, 0x67, 0x68, 0x66, 0x8e,
+	0x3e, 0xad, 0xa6, 0x2c, 0xc9, 0xde, 0x5a, 0x78, 0xf6, 0xf5, 0xed, 0xf5, 0x91, 0x4e, 0xb5, 0x84,
+	0x8a, 0x67, 0x2c, 0x59, 0xc0, 0x42, 0x12, 0x30, 0xa8, 0xbe, 0x53, 0x50, 0xa1, 0xc5, 0xdc, 0x3d,
+	0xe0, 0x77, 0x62, 0x51, 0x2f, 0x70, 0x77, 0x71, 0x8f, 0x81, 0x73, 0x65, 0xd7, 0x85, 0xc8, 0x75,
+	0xa4, 0x20, 0x5c, 0xcf, 0x5b, 0x72, 0xa7, 0xae, 0xa2, 0xbc, 0xbd, 0x8f, 0x83, 0x00, 0xba, 0x6d,
+	0xcf, 0x61, 0x5a, 0xba, 0x92, 0xae, 0xe5, 0x2c, 0x24, 0x8f, 0x76, 0x1c, 0x16, 0x05, 0x3a, 0x10,
+	0x10, 0x9f, 0x69, 0x19, 0x81, 0xc9, 0xdd, 0x54, 0x39, 0x15, 0xa4, 0xcf, 0xb6, 0x9c, 0x54, 0xf5,
+	0x4d, 0x41, 0x7f, 0x47, 0x94, 0x01, 0x40, 0xb8, 0x13, 0x70, 0xa0, 0x3e, 0x38, 0x1e, 0xe6, 0xb0,
+	0x6d, 0xdb, 0xa4, 0x17, 0x70, 0x75, 0x0d, 0x65, 0x99, 0xe7, 0x06, 0x40, 0x2f, 0xad, 0x4b, 0xf2,
+	0xd4, 0x15, 0x84, 0x46, 0xe6, 0x45, 0x61, 0x39, 0x2b, 0x97, 0x78, 0x57, 0xff, 0x47, 0x8b, 0x84,
+	0x7a, 0xae, 0x17, 0xe0, 0x6e, 0x9b, 0x41, 0xe0, 0x00, 0xd5, 0xd2, 0x82, 0xb3, 0x30, 0x3c, 0xde,
+	0x13, 0xa7, 0xea, 0x0d, 0x54, 0xe0, 0xa4, 0x4d, 0xc1, 0x26, 0x7d, 0xa0, 0xb8, 0xd3, 0x85, 0x36,
+	0x8e, 0x3d, 0x69, 0x19, 0x71, 0x59, 0xcb, 0x9c, 0x58, 0x23, 0x70, 0xe8, 0xb7, 0x88, 0x7e, 0x0f,
+	0x09, 0xe5, 0x51, 0xea, 0x39, 0x21, 0x9b, 0x8d, 0xb6, 0x3b, 0xce, 0x56, 0x3e, 0xba, 0x1a, 0xe9,
+	0xb1, 0xfa, 0x42, 0x41, 0xff, 0x5e, 0x50, 0xf5, 0xf0, 0x76, 0x54, 0x8c, 0xe6, 0xd8, 0x00, 0x42,
+	0xae, 0x29, 0x95, 0x74, 0x2d, 0xbf, 0x5e, 0x32, 0x64, 0xe5, 0xd1, 0xf0, 0x18, 0x72, 0x78, 0x8c,
+	0xdb, 0xc4, 0x0b, 0x9a, 0x6b, 0x51, 0x5b, 0xbd, 0xf9, 0xbc, 0x5a, 0x73, 0x3d, 0xbe, 0xdf, 0xeb,
+	0x18, 0x36, 0xf1, 0x65, 0xb7, 0xcb, 0x9f, 0x3a, 0x73, 0x0e, 0x4c, 0x7e, 0x18, 0x02, 0x13, 0x01,
+	0xcc, 0x8a, 0x95, 0xab, 0x2f, 0x15, 0x94, 0x8f, 0xbf, 0x91, 0x45, 0x7a, 0x1c, 0xae, 0xdc, 0x4b,
+	0x1b, 0x68, 0x8e, 0x46, 0x02, 0x72, 0x00, 0x8a, 0xd3, 0x03, 0x20, 0xf4, 0x65, 0xff, 0xc7, 0xdc,
+	0xa9, 0x7e, 0xf9, 0x0b, 0x2d, 0x8d, 0x79, 0x49, 0x9a, 0xa4, 0x8b, 0x16, 0x5a, 0xcc, 0xb5, 0xc0,
+	0x27, 0x7d, 0xf8, 0x35, 0x97, 0x2a, 0xca, 0x04, 0xd8, 0x07, 0xd9, 0x16, 0x62, 0x3d, 0x65, 0x42,
+	0x43, 0x85, 0xc9, 0x6c, 0x43, 0x1f, 0xeb, 0xcf, 0x33, 0x28, 0xdd, 0x62, 0xae, 0xfa, 0x10, 0xfd,
+	0x31, 0xf1, 0xaf, 0xf1, 0xcf, 0x74, 0xb1, 0x67, 0xa6, 0xb8, 0x7c, 0xed, 0x52, 0x4a, 0xf2, 0xd1,
+	0x1f, 0xa3, 0xa5, 0x59, 0x43, 0x5e, 0x9b, 0xa9, 0x30, 0x83, 0x59, 0x5e, 0xfb, 0x51, 0x66, 0x92,
+	0xf2, 0xa9, 0x82, 0xb4, 0x73, 0x47, 0xb0, 0x3e, 0x5b, 0xee, 0x1c, 0x7a, 0xf9, 0xe6, 0x4f, 0xd1,
+	0x13, 0x0b, 0xbb, 0x68, 0x3e, 0xe9, 0xc1, 0x95, 0xf3, 0x0a, 0x10, 0x70, 0xf9, 0xbf, 0x0b, 0xe1,
+	0x44, 0xf1, 0x3e, 0xca, 0x8f, 0xb7, 0x4c, 0x65, 0x66, 0xd4, 0x18, 0xa3, 0x5c, 0xbb, 0x8c, 0x31,
+	0x94, 0x6e, 0x86, 0x47, 0x27, 0xba, 0x72, 0x7c, 0xa2, 0x2b, 0x5f, 0x4e, 0x74, 0xe5, 0xd5, 0xa9,
+	0x9e, 0x3a, 0x3e, 0xd5, 0x53, 0x1f, 0x4f, 0xf5, 0xd4, 0x83, 0x7b, 0xd3, 0xf3, 0xe7, 0x75, 0xec,
+	0x3a, 0x0e, 0x43, 0x66, 0xfa, 0x9e, 0xe3, 0x74, 0x61, 0x80, 0x29, 0x98, 0x71, 0xa2, 0xba, 0xcc,
+	0x54, 0x1f, 0x43, 0xfa, 0xb7, 0xcc, 0xc9, 0x87, 0x4e, 0xcc, 0x6c, 0x27, 0x2b, 0xde, 0xac, 0x8d,
+	0xef, 0x03, 0x00, 0x80, 0x27, 0x8f, 0xb6, 0x6c, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -179,6 +649,18 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetForwardingPaused defines a governance operation for halting or resuming
+	// new forwards, either globally or for specific channels or denoms.
+	SetForwardingPaused(ctx context.Context, in *MsgSetForwardingPaused, opts ...grpc.CallOption) (*MsgSetForwardingPausedResponse, error)
+	// SweepIntermediateAccount returns funds stranded on the intermediate
+	// account of an original sender to that sender.
+	SweepIntermediateAccount(ctx context.Context, in *MsgSweepIntermediateAccount, opts ...grpc.CallOption) (*MsgSweepIntermediateAccountResponse, error)
+	// SetRoute defines a governance operation for registering a route, or
+	// replacing the hops of a registered route.
+	SetRoute(ctx context.Context, in *MsgSetRoute, opts ...grpc.CallOption) (*MsgSetRouteResponse, error)
+	// RemoveRoute defines a governance operation for removing a registered
+	// route.
+	RemoveRoute(ctx context.Context, in *MsgRemoveRoute, opts ...grpc.CallOption) (*MsgRemoveRouteResponse, error)
 }
 
 type msgClient struct {
@@ -198,13 +680,61 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a governance operation for updating the x/packetforward module
-	// parameters. The authority is hard-coded to the x/gov module account.
-	//
-	// Since: cosmos-sdk 0.47
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (c *msgClient) SetForwardingPaused(ctx context.Context, in *MsgSetForwardingPaused, opts ...grpc.CallOption) (*MsgSetForwardingPausedResponse, error) {
+	out := new(MsgSetForwardingPausedResponse)
+	err := c.cc.Invoke(ctx, "/packetforward.v1.Msg/SetForwardingPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SweepIntermediateAccount(ctx context.Context, in *MsgSweepIntermediateAccount, opts ...grpc.CallOption) (*MsgSweepIntermediateAccountResponse, error) {
+	out := new(MsgSweepIntermediateAccountResponse)
+	err := c.cc.Invoke(ctx, "/packetforward.v1.Msg/SweepIntermediateAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRoute(ctx context.Context, in *MsgSetRoute, opts ...grpc.CallOption) (*MsgSetRouteResponse, error) {
+	out := new(MsgSetRouteResponse)
+	err := c.cc.Invoke(ctx, "/packetforward.v1.Msg/SetRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveRoute(ctx context.Context, in *MsgRemoveRoute, opts ...grpc.CallOption) (*MsgRemoveRouteResponse, error) {
+	out := new(MsgRemoveRouteResponse)
+	err := c.cc.Invoke(ctx, "/packetforward.v1.Msg/RemoveRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a governance operation for updating the x/packetforward module
+	// parameters. The authority is hard-coded to the x/gov module account.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetForwardingPaused defines a governance operation for halting or resuming
+	// new forwards, either globally or for specific channels or denoms.
+	SetForwardingPaused(context.Context, *MsgSetForwardingPaused) (*MsgSetForwardingPausedResponse, error)
+	// SweepIntermediateAccount returns funds stranded on the intermediate
+	// account of an original sender to that sender.
+	SweepIntermediateAccount(context.Context, *MsgSweepIntermediateAccount) (*MsgSweepIntermediateAccountResponse, error)
+	// SetRoute defines a governance operation for registering a route, or
+	// replacing the hops of a registered route.
+	SetRoute(context.Context, *MsgSetRoute) (*MsgSetRouteResponse, error)
+	// RemoveRoute defines a governance operation for removing a registered
+	// route.
+	RemoveRoute(context.Context, *MsgRemoveRoute) (*MsgRemoveRouteResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -214,6 +744,18 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetForwardingPaused(ctx context.Context, req *MsgSetForwardingPaused) (*MsgSetForwardingPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetForwardingPaused not implemented")
+}
+func (*UnimplementedMsgServer) SweepIntermediateAccount(ctx context.Context, req *MsgSweepIntermediateAccount) (*MsgSweepIntermediateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SweepIntermediateAccount not implemented")
+}
+func (*UnimplementedMsgServer) SetRoute(ctx context.Context, req *MsgSetRoute) (*MsgSetRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRoute not implemented")
+}
+func (*UnimplementedMsgServer) RemoveRoute(ctx context.Context, req *MsgRemoveRoute) (*MsgRemoveRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRoute not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -237,6 +779,78 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetForwardingPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetForwardingPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetForwardingPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/packetforward.v1.Msg/SetForwardingPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetForwardingPaused(ctx, req.(*MsgSetForwardingPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SweepIntermediateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSweepIntermediateAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SweepIntermediateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/packetforward.v1.Msg/SweepIntermediateAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SweepIntermediateAccount(ctx, req.(*MsgSweepIntermediateAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRoute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/packetforward.v1.Msg/SetRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRoute(ctx, req.(*MsgSetRoute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRoute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/packetforward.v1.Msg/RemoveRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRoute(ctx, req.(*MsgRemoveRoute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "packetforward.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -245,6 +859,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetForwardingPaused",
+			Handler:    _Msg_SetForwardingPaused_Handler,
+		},
+		{
+			MethodName: "SweepIntermediateAccount",
+			Handler:    _Msg_SweepIntermediateAccount_Handler,
+		},
+		{
+			MethodName: "SetRoute",
+			Handler:    _Msg_SetRoute_Handler,
+		},
+		{
+			MethodName: "RemoveRoute",
+			Handler:    _Msg_RemoveRoute_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "packetforward/v1/tx.proto",
@@ -275,86 +905,1346 @@ func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if err != nil {
 			return 0, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetForwardingPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetForwardingPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetForwardingPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ChannelIds) > 0 {
+		for iNdEx := len(m.ChannelIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChannelIds[iNdEx])
+			copy(dAtA[i:], m.ChannelIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetForwardingPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetForwardingPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetForwardingPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSweepIntermediateAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSweepIntermediateAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSweepIntermediateAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ToRecoverableAccount {
+		i--
+		if m.ToRecoverableAccount {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.OriginalSender) > 0 {
+		i -= len(m.OriginalSender)
+		copy(dAtA[i:], m.OriginalSender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginalSender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSweepIntermediateAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSweepIntermediateAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSweepIntermediateAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Swept) > 0 {
+		for iNdEx := len(m.Swept) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Swept[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetForwardingPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	if len(m.ChannelIds) > 0 {
+		for _, s := range m.ChannelIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetForwardingPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSweepIntermediateAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OriginalSender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ToRecoverableAccount {
+		n += 2
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSweepIntermediateAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Swept) > 0 {
+		for _, e := range m.Swept {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Route.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetForwardingPaused) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetForwardingPaused: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetForwardingPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelIds = append(m.ChannelIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetForwardingPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetForwardingPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetForwardingPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSweepIntermediateAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSweepIntermediateAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSweepIntermediateAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToRecoverableAccount", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ToRecoverableAccount = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSweepIntermediateAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSweepIntermediateAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSweepIntermediateAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swept", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Swept = append(m.Swept, types.Coin{})
+			if err := m.Swept[len(m.Swept)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return nil
 }
+func (m *MsgSetRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -377,10 +2267,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -417,9 +2307,9 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -429,24 +2319,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -469,7 +2358,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveRouteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -492,10 +2381,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveRouteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
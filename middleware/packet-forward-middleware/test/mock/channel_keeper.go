@@ -80,6 +80,21 @@ func (mr *MockChannelKeeperMockRecorder) GetPacketCommitment(arg0, arg1, arg2, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPacketCommitment", reflect.TypeOf((*MockChannelKeeper)(nil).GetPacketCommitment), arg0, arg1, arg2, arg3)
 }
 
+// GetPacketReceipt mocks base method.
+func (m *MockChannelKeeper) GetPacketReceipt(arg0 types.Context, arg1, arg2 string, arg3 uint64) (string, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPacketReceipt", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetPacketReceipt indicates an expected call of GetPacketReceipt.
+func (mr *MockChannelKeeperMockRecorder) GetPacketReceipt(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPacketReceipt", reflect.TypeOf((*MockChannelKeeper)(nil).GetPacketReceipt), arg0, arg1, arg2, arg3)
+}
+
 // LookupModuleByChannel mocks base method.
 func (m *MockChannelKeeper) LookupModuleByChannel(arg0 types.Context, arg1, arg2 string) (string, *types0.Capability, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types (interfaces: FeeKeeper)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	types "github.com/cosmos/ibc-go/v7/modules/apps/29-fee/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFeeKeeper is a mock of FeeKeeper interface.
+type MockFeeKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeeKeeperMockRecorder
+}
+
+// MockFeeKeeperMockRecorder is the mock recorder for MockFeeKeeper.
+type MockFeeKeeperMockRecorder struct {
+	mock *MockFeeKeeper
+}
+
+// NewMockFeeKeeper creates a new mock instance.
+func NewMockFeeKeeper(ctrl *gomock.Controller) *MockFeeKeeper {
+	mock := &MockFeeKeeper{ctrl: ctrl}
+	mock.recorder = &MockFeeKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeKeeper) EXPECT() *MockFeeKeeperMockRecorder {
+	return m.recorder
+}
+
+// PayPacketFee mocks base method.
+func (m *MockFeeKeeper) PayPacketFee(arg0 context.Context, arg1 *types.MsgPayPacketFee) (*types.MsgPayPacketFeeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PayPacketFee", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgPayPacketFeeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PayPacketFee indicates an expected call of PayPacketFee.
+func (mr *MockFeeKeeperMockRecorder) PayPacketFee(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayPacketFee", reflect.TypeOf((*MockFeeKeeper)(nil).PayPacketFee), arg0, arg1)
+}
@@ -2,7 +2,6 @@ package test
 
 import (
 	"testing"
-	"time"
 
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward"
 	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/keeper"
@@ -34,11 +33,14 @@ func NewTestSetup(t *testing.T, ctl *gomock.Controller) *Setup {
 	channelKeeperMock := mock.NewMockChannelKeeper(ctl)
 	distributionKeeperMock := mock.NewMockDistributionKeeper(ctl)
 	bankKeeperMock := mock.NewMockBankKeeper(ctl)
+	feeKeeperMock := mock.NewMockFeeKeeper(ctl)
 	ibcModuleMock := mock.NewMockIBCModule(ctl)
 	ics4WrapperMock := mock.NewMockICS4Wrapper(ctl)
 
 	paramsKeeper := initializer.paramsKeeper()
-	packetforwardKeeper := initializer.packetforwardKeeper(paramsKeeper, transferKeeperMock, channelKeeperMock, distributionKeeperMock, bankKeeperMock, ics4WrapperMock)
+	packetforwardKeeper, packetforwardStoreKey := initializer.packetforwardKeeper(paramsKeeper, transferKeeperMock, channelKeeperMock, distributionKeeperMock, bankKeeperMock, ics4WrapperMock)
+
+	packetforwardKeeper.SetFeeKeeper(feeKeeperMock)
 
 	require.NoError(t, initializer.StateStore.LoadLatestVersion())
 
@@ -52,16 +54,21 @@ func NewTestSetup(t *testing.T, ctl *gomock.Controller) *Setup {
 		Keepers: &testKeepers{
 			ParamsKeeper:        &paramsKeeper,
 			PacketForwardKeeper: packetforwardKeeper,
+
+			PacketForwardStoreKey: packetforwardStoreKey,
 		},
 
 		Mocks: &testMocks{
 			TransferKeeperMock:     transferKeeperMock,
+			ChannelKeeperMock:      channelKeeperMock,
 			DistributionKeeperMock: distributionKeeperMock,
+			BankKeeperMock:         bankKeeperMock,
+			FeeKeeperMock:          feeKeeperMock,
 			IBCModuleMock:          ibcModuleMock,
 			ICS4WrapperMock:        ics4WrapperMock,
 		},
 
-		ForwardMiddleware: initializer.forwardMiddleware(ibcModuleMock, packetforwardKeeper, 0, keeper.DefaultForwardTransferPacketTimeoutTimestamp, keeper.DefaultRefundTransferPacketTimeoutTimestamp),
+		ForwardMiddleware: initializer.forwardMiddleware(ibcModuleMock, packetforwardKeeper),
 	}
 }
 
@@ -77,11 +84,17 @@ type Setup struct {
 type testKeepers struct {
 	ParamsKeeper        *paramskeeper.Keeper
 	PacketForwardKeeper *keeper.Keeper
+
+	// PacketForwardStoreKey is the store key of the packetforward module, for writing state the keeper rejects.
+	PacketForwardStoreKey storetypes.StoreKey
 }
 
 type testMocks struct {
 	TransferKeeperMock     *mock.MockTransferKeeper
+	ChannelKeeperMock      *mock.MockChannelKeeper
 	DistributionKeeperMock *mock.MockDistributionKeeper
+	BankKeeperMock         *mock.MockBankKeeper
+	FeeKeeperMock          *mock.MockFeeKeeper
 	IBCModuleMock          *mock.MockIBCModule
 	ICS4WrapperMock        *mock.MockICS4Wrapper
 }
@@ -134,7 +147,7 @@ func (i initializer) packetforwardKeeper(
 	distributionKeeper types.DistributionKeeper,
 	bankKeeper types.BankKeeper,
 	ics4Wrapper porttypes.ICS4Wrapper,
-) *keeper.Keeper {
+) (*keeper.Keeper, storetypes.StoreKey) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
 	i.StateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, i.DB)
 
@@ -151,9 +164,9 @@ func (i initializer) packetforwardKeeper(
 		govModuleAddress,
 	)
 
-	return packetforwardKeeper
+	return packetforwardKeeper, storeKey
 }
 
-func (i initializer) forwardMiddleware(app porttypes.IBCModule, k *keeper.Keeper, retriesOnTimeout uint8, forwardTimeout time.Duration, refundTimeout time.Duration) packetforward.IBCMiddleware {
-	return packetforward.NewIBCMiddleware(app, k, retriesOnTimeout, forwardTimeout, refundTimeout)
+func (i initializer) forwardMiddleware(app porttypes.IBCModule, k *keeper.Keeper) packetforward.IBCMiddleware {
+	return packetforward.NewIBCMiddlewareWithParams(app, k)
 }
@@ -1 +1,19 @@
 package ibctesting
+
+import (
+	"encoding/json"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/testing/simapp"
+
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+// SetupTestingApp returns a simapp wired with the packetforward module on top of the transfer module, and
+// its default genesis. The invariants of all modules are asserted at the end of every block.
+func SetupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	app := simapp.NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, simapp.DefaultNodeHome, 1,
+		simapp.EmptyAppOptions{})
+	return app, simapp.NewDefaultGenesisState(app.AppCodec())
+}
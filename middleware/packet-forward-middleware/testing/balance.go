@@ -0,0 +1,49 @@
+package ibctesting
+
+import (
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+// GetBalance returns the balance of the denom held by the address on the chain.
+func GetBalance(chain *ibctesting.TestChain, address sdk.AccAddress, denom string) sdk.Coin {
+	return GetSimApp(chain).BankKeeper.GetBalance(chain.GetContext(), address, denom)
+}
+
+// AssertBalance asserts that the address holds the expected balance on the chain.
+func AssertBalance(chain *ibctesting.TestChain, address sdk.AccAddress, expected sdk.Coin) {
+	require.Equal(chain.T, expected.String(), GetBalance(chain, address, expected.Denom).String(),
+		"unexpected balance of %s on %s", address, chain.ChainID)
+}
+
+// AssertEscrow asserts that the escrow account of the endpoint holds the expected balance.
+func AssertEscrow(endpoint *ibctesting.Endpoint, expected sdk.Coin) {
+	AssertBalance(endpoint.Chain, transfertypes.GetEscrowAddress(endpoint.ChannelConfig.PortID, endpoint.ChannelID), expected)
+}
+
+// GetInFlightPackets returns the in-flight packets of the packetforward module on the chain, keyed by the
+// forwarded packet.
+func GetInFlightPackets(chain *ibctesting.TestChain) map[string]types.InFlightPacket {
+	inFlightPackets := make(map[string]types.InFlightPacket)
+	GetSimApp(chain).PacketForwardKeeper.IterateInFlightPackets(chain.GetContext(),
+		func(key types.InFlightPacketKey, inFlightPacket types.InFlightPacket) bool {
+			inFlightPackets[string(types.RefundPacketKey(key.K1().K1(), key.K1().K2(), key.K2()))] = inFlightPacket
+			return false
+		})
+	return inFlightPackets
+}
+
+// AssertNoInFlightPackets asserts that the packetforward module on the chain has no in-flight packets.
+func AssertNoInFlightPackets(chain *ibctesting.TestChain) {
+	require.Empty(chain.T, GetInFlightPackets(chain), "unexpected in-flight packets on %s", chain.ChainID)
+}
+
+// GetPendingRefunds returns the pending refunds of the packetforward module on the chain.
+func GetPendingRefunds(chain *ibctesting.TestChain) []types.PendingRefund {
+	return GetSimApp(chain).PacketForwardKeeper.GetAllPendingRefunds(chain.GetContext())
+}
@@ -0,0 +1,67 @@
+package ibctesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	pfmtesting "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/testing"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestForward_ChannelCloseRefundsOnTimeoutOnClose(t *testing.T) {
+	coord := pfmtesting.NewCoordinator(t, 3)
+	route := coord.SetupRoute(coord.Chains...)
+	chainA, chainB, chainC := route.Chain(0), route.Chain(1), route.Chain(2)
+
+	sender := chainA.SenderAccount.GetAddress()
+	receiver := chainC.SenderAccounts[1].SenderAccount.GetAddress()
+	balance := pfmtesting.GetBalance(chainA, sender, sdk.DefaultBondDenom)
+
+	packet := route.Forward(sdk.NewCoin(sdk.DefaultBondDenom, amount), receiver.String(), 1, 10*time.Minute)
+	packets := route.RelayHops(packet, 1)
+
+	// the forward is only reported when its channel is closed, since it is not proven that it was not received.
+	result, err := pfmtesting.CloseChannel(route.Paths[1])
+	require.NoError(t, err)
+	require.Empty(t, writtenAcks(t, result.Events))
+	requireEvent(t, result.Events, types.EventTypeInFlightPacketChannelClosed)
+	require.Len(t, pfmtesting.GetInFlightPackets(chainB), 1)
+
+	// the timeout on close proves it, so the forward is refunded instead of retried over the closed channel.
+	result, err = pfmtesting.RelayTimeoutOnClose(route.Paths[1], packets[1])
+	require.NoError(t, err)
+	require.Nil(t, result.Packet)
+	require.NotNil(t, result.Ack)
+	requireAck(t, route.RelayAcks(packets[:1], result.Ack), false)
+
+	pfmtesting.AssertNoInFlightPackets(chainB)
+	pfmtesting.AssertBalance(chainA, sender, balance)
+}
+
+func TestForward_ChannelCloseKeepsReceivedForward(t *testing.T) {
+	coord := pfmtesting.NewCoordinator(t, 3)
+	route := coord.SetupRoute(coord.Chains...)
+	chainA, chainB, chainC := route.Chain(0), route.Chain(1), route.Chain(2)
+
+	sender := chainA.SenderAccount.GetAddress()
+	receiver := chainC.SenderAccounts[1].SenderAccount.GetAddress()
+	balance := pfmtesting.GetBalance(chainA, sender, sdk.DefaultBondDenom)
+
+	packet := route.Forward(sdk.NewCoin(sdk.DefaultBondDenom, amount), receiver.String(), 0, 0)
+	packets := route.RelayHops(packet, 1)
+	relayed, err := pfmtesting.RelayPacket(route.Paths[1], packets[1])
+	require.NoError(t, err)
+	requireAck(t, relayed.Ack, true)
+
+	// the forward was received before the channel was closed, so it must not be refunded on close.
+	result, err := pfmtesting.CloseChannel(route.Paths[1])
+	require.NoError(t, err)
+	require.Empty(t, writtenAcks(t, result.Events))
+	require.Len(t, pfmtesting.GetInFlightPackets(chainB), 1)
+
+	pfmtesting.AssertBalance(chainA, sender, balance.SubAmount(amount))
+	pfmtesting.AssertBalance(chainC, receiver, sdk.NewCoin(route.Denom(2, sdk.DefaultBondDenom), amount))
+}
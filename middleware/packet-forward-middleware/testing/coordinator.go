@@ -0,0 +1,76 @@
+package ibctesting
+
+import (
+	"testing"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/testing/simapp"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+// Coordinator is an ibc-go testing coordinator whose chains run the simapp of this repository.
+type Coordinator struct {
+	*ibctesting.Coordinator
+
+	// Chains are the chains of the coordinator in the order they were created.
+	Chains []*ibctesting.TestChain
+}
+
+// NewCoordinator returns a coordinator of n chains that are wired with the packetforward module.
+func NewCoordinator(t *testing.T, n int) *Coordinator {
+	ibctesting.DefaultTestingAppInit = SetupTestingApp
+	coord := ibctesting.NewCoordinator(t, n)
+
+	chains := make([]*ibctesting.TestChain, n)
+	for i := range chains {
+		chains[i] = coord.GetChain(ibctesting.GetChainID(i + 1))
+	}
+
+	return &Coordinator{
+		Coordinator: coord,
+		Chains:      chains,
+	}
+}
+
+// NewTransferPath returns a path between the transfer ports of chainA and chainB. The channel is unordered
+// unless the order is changed before the path is set up.
+func NewTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		endpoint.ChannelConfig.PortID = ibctesting.TransferPort
+		endpoint.ChannelConfig.Version = transfertypes.Version
+		endpoint.ChannelConfig.Order = channeltypes.UNORDERED
+	}
+	return path
+}
+
+// SetupTransferPath creates the clients, connections and a transfer channel between chainA and chainB.
+func (coord *Coordinator) SetupTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := NewTransferPath(chainA, chainB)
+	coord.Setup(path)
+	return path
+}
+
+// SetChannelOrder sets the order of both channel ends of the path. The transfer module only opens unordered
+// channels, so this stands in for a transfer stack that is wired over a channel of the given order.
+func SetChannelOrder(path *ibctesting.Path, order channeltypes.Order) error {
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		endpoint.ChannelConfig.Order = order
+		channel := endpoint.GetChannel()
+		channel.Ordering = order
+		endpoint.SetChannel(channel)
+	}
+	path.EndpointA.Chain.Coordinator.CommitBlock(path.EndpointA.Chain, path.EndpointB.Chain)
+
+	if err := path.EndpointA.UpdateClient(); err != nil {
+		return err
+	}
+	return path.EndpointB.UpdateClient()
+}
+
+// GetSimApp returns the simapp of the chain.
+func GetSimApp(chain *ibctesting.TestChain) *simapp.SimApp {
+	return simapp.GetSimApp(chain)
+}
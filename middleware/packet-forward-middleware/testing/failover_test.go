@@ -0,0 +1,159 @@
+package ibctesting_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	pfmtesting "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/testing"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+// setupFailover opens an alternate path between the second and third chain of the route and makes its channel on
+// the second chain equivalent to the channel of the route.
+func setupFailover(coord *pfmtesting.Coordinator, route *pfmtesting.Route) *ibctesting.Path {
+	alternatePath := coord.SetupTransferPath(route.Chain(1), route.Chain(2))
+	pfmtesting.UpdateParams(route.Chain(1), func(params *types.Params) {
+		params.ChannelEquivalences = []types.ChannelEquivalence{
+			types.NewChannelEquivalence(transfertypes.PortID, route.Paths[1].EndpointA.ChannelID, alternatePath.EndpointA.ChannelID),
+		}
+	})
+	return alternatePath
+}
+
+// requireFailoverEvent asserts that the events include a failover from the requested channel to the channel after
+// the attempted channels.
+func requireFailoverEvent(t *testing.T, events sdk.Events, requestedChannel, channel string, attempted ...string) {
+	t.Helper()
+
+	for _, event := range events {
+		if event.Type != types.EventTypeForwardFailover {
+			continue
+		}
+		attributes := make(map[string]string)
+		for _, attribute := range event.Attributes {
+			attributes[attribute.Key] = attribute.Value
+		}
+		require.Equal(t, requestedChannel, attributes[types.AttributeKeyRequestedChannelID])
+		require.Equal(t, channel, attributes[types.AttributeKeyForwardChannelID])
+		require.Equal(t, strings.Join(attempted, ","), attributes[types.AttributeKeyAttemptedChannelIDs])
+		return
+	}
+	require.Fail(t, "no forward failover event")
+}
+
+func TestForward_FailoverOnTimeout(t *testing.T) {
+	coord := pfmtesting.NewCoordinator(t, 3)
+	route := coord.SetupRoute(coord.Chains...)
+	chainB, chainC := route.Chain(1), route.Chain(2)
+	alternatePath := setupFailover(coord, route)
+	channel, alternateChannel := route.Paths[1].EndpointA.ChannelID, alternatePath.EndpointA.ChannelID
+
+	receiver := chainC.SenderAccounts[1].SenderAccount.GetAddress()
+
+	packet := route.Forward(sdk.NewCoin(sdk.DefaultBondDenom, amount), receiver.String(), 1, 10*time.Minute)
+	packets := route.RelayHops(packet, 1)
+	require.Equal(t, channel, packets[1].SourceChannel)
+
+	// the retry of the timed out forward fails over to the equivalent channel.
+	result := route.Timeout(1, packets[1])
+	require.NotNil(t, result.Packet)
+	require.Equal(t, alternateChannel, result.Packet.SourceChannel)
+	requireFailoverEvent(t, result.Events, channel, alternateChannel, channel, alternateChannel)
+
+	inFlightPackets := pfmtesting.GetInFlightPackets(chainB)
+	require.Len(t, inFlightPackets, 1)
+	for _, inFlightPacket := range inFlightPackets {
+		require.Equal(t, []string{channel, alternateChannel}, inFlightPacket.AttemptedChannelIds)
+	}
+
+	relayed, err := pfmtesting.RelayPacket(alternatePath, *result.Packet)
+	require.NoError(t, err)
+	require.NotNil(t, relayed.Ack)
+	result, err = pfmtesting.RelayAck(alternatePath, *result.Packet, relayed.Ack)
+	require.NoError(t, err)
+	requireAck(t, route.RelayAcks(packets[:1], result.Ack), true)
+
+	denom := transfertypes.GetPrefixedDenom(alternatePath.EndpointB.ChannelConfig.PortID, alternatePath.EndpointB.ChannelID,
+		transfertypes.GetPrefixedDenom(route.Paths[0].EndpointB.ChannelConfig.PortID, route.Paths[0].EndpointB.ChannelID, sdk.DefaultBondDenom))
+	pfmtesting.AssertBalance(chainC, receiver, sdk.NewCoin(transfertypes.ParseDenomTrace(denom).IBCDenom(), amount))
+	pfmtesting.AssertNoInFlightPackets(chainB)
+}
+
+func TestForward_FailoverOnFrozenClient(t *testing.T) {
+	coord := pfmtesting.NewCoordinator(t, 3)
+	route := coord.SetupRoute(coord.Chains...)
+	chainB, chainC := route.Chain(1), route.Chain(2)
+	alternatePath := setupFailover(coord, route)
+	channel, alternateChannel := route.Paths[1].EndpointA.ChannelID, alternatePath.EndpointA.ChannelID
+
+	clientState := route.Paths[1].EndpointA.GetClientState().(*ibctm.ClientState)
+	clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+	route.Paths[1].EndpointA.SetClientState(clientState)
+
+	receiver := chainC.SenderAccounts[1].SenderAccount.GetAddress()
+
+	// the forward cannot be sent over the channel of the frozen client, so it is sent over the equivalent channel.
+	packet := route.Forward(sdk.NewCoin(sdk.DefaultBondDenom, amount), receiver.String(), 0, 10*time.Minute)
+	result, err := pfmtesting.RelayPacket(route.Paths[0], packet)
+	require.NoError(t, err)
+	require.NotNil(t, result.Packet)
+	require.Equal(t, alternateChannel, result.Packet.SourceChannel)
+	requireFailoverEvent(t, result.Events, channel, alternateChannel, channel, alternateChannel)
+
+	relayed, err := pfmtesting.RelayPacket(alternatePath, *result.Packet)
+	require.NoError(t, err)
+	result, err = pfmtesting.RelayAck(alternatePath, *result.Packet, relayed.Ack)
+	require.NoError(t, err)
+	requireAck(t, route.RelayAcks([]channeltypes.Packet{packet}, result.Ack), true)
+	pfmtesting.AssertNoInFlightPackets(chainB)
+}
+
+func TestForward_FailoverIncompatibleDenom(t *testing.T) {
+	coord := pfmtesting.NewCoordinator(t, 3)
+	route := coord.SetupRoute(coord.Chains...)
+	chainA, chainB, chainC := route.Chain(0), route.Chain(1), route.Chain(2)
+	setupFailover(coord, route)
+
+	// the native token of C is sent to A over the route in reverse.
+	reverse := &pfmtesting.Route{Paths: []*ibctesting.Path{reversePath(route.Paths[1]), reversePath(route.Paths[0])}}
+	sender := chainA.SenderAccount.GetAddress()
+	packet := reverse.Forward(sdk.NewCoin(sdk.DefaultBondDenom, amount), sender.String(), 0, 0)
+	requireAck(t, reverse.Relay(packet), true)
+
+	denom := reverse.Denom(2, sdk.DefaultBondDenom)
+	pfmtesting.AssertBalance(chainA, sender, sdk.NewCoin(denom, amount))
+
+	clientState := route.Paths[1].EndpointA.GetClientState().(*ibctm.ClientState)
+	clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+	route.Paths[1].EndpointA.SetClientState(clientState)
+
+	// the token returns to C over the channel of the frozen client. Over the equivalent channel it would be received
+	// as a voucher instead, so the forward does not fail over and is refunded.
+	receiver := chainC.SenderAccounts[1].SenderAccount.GetAddress()
+	packet = route.Forward(sdk.NewCoin(denom, amount), receiver.String(), 0, 0)
+	result, err := pfmtesting.RelayPacket(route.Paths[0], packet)
+	require.NoError(t, err)
+	require.Nil(t, result.Packet)
+	for _, event := range result.Events {
+		require.NotEqual(t, types.EventTypeForwardFailover, event.Type)
+	}
+	requireAck(t, route.RelayAcks([]channeltypes.Packet{packet}, result.Ack), false)
+
+	pfmtesting.AssertBalance(chainA, sender, sdk.NewCoin(denom, amount))
+	pfmtesting.AssertNoInFlightPackets(chainB)
+}
+
+// reversePath returns the path with its endpoints swapped.
+func reversePath(path *ibctesting.Path) *ibctesting.Path {
+	return &ibctesting.Path{EndpointA: path.EndpointB, EndpointB: path.EndpointA}
+}
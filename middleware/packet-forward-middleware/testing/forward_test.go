@@ -0,0 +1,237 @@
+package ibctesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	pfmtesting "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/testing"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+)
+
+var amount = sdk.NewInt(1_000_000)
+
+func requireAck(t *testing.T, bz []byte, success bool) {
+	t.Helper()
+
+	var ack channeltypes.Acknowledgement
+	require.NoError(t, transfertypes.ModuleCdc.UnmarshalJSON(bz, &ack))
+	require.Equal(t, success, ack.Success(), "unexpected acknowledgement %s", bz)
+}
+
+func TestForward_MultiHopSuccess(t *testing.T) {
+	coord := pfmtesting.NewCoordinator(t, 4)
+	route := coord.SetupRoute(coord.Chains...)
+	chainA, chainD := route.Chain(0), route.Chain(3)
+
+	sender := chainA.SenderAccount.GetAddress()
+	receiver := chainD.SenderAccounts[1].SenderAccount.GetAddress()
+	balance := pfmtesting.GetBalance(chainA, sender, sdk.DefaultBondDenom)
+
+	packet := route.Forward(sdk.NewCoin(sdk.DefaultBondDenom, amount), receiver.String(), 0, 0)
+	requireAck(t, route.Relay(packet), true)
+
+	pfmtesting.AssertBalance(chainA, sender, balance.SubAmount(amount))
+	pfmtesting.AssertEscrow(route.Paths[0].EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, amount))
+	pfmtesting.AssertBalance(chainD, receiver, sdk.NewCoin(route.Denom(3, sdk.DefaultBondDenom), amount))
+	for _, chain := range route.Chains()[1:3] {
+		pfmtesting.AssertNoInFlightPackets(chain)
+	}
+}
+
+func TestForward_ErrorAckRefund(t *testing.T) {
+	coord := pfmtesting.NewCoordinator(t, 4)
+	route := coord.SetupRoute(coord.Chains...)
+	chainA := route.Chain(0)
+
+	sender := chainA.SenderAccount.GetAddress()
+	balance := pfmtesting.GetBalance(chainA, sender, sdk.DefaultBondDenom)
+
+	// the last chain cannot credit an invalid receiver and acknowledges with an error.
+	packet := route.Forward(sdk.NewCoin(sdk.DefaultBondDenom, amount), "invalid", 0, 0)
+	requireAck(t, route.Relay(packet), false)
+
+	pfmtesting.AssertBalance(chainA, sender, balance)
+	pfmtesting.AssertEscrow(route.Paths[0].EndpointA, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0))
+	for i, chain := range route.Chains()[1:3] {
+		pfmtesting.AssertEscrow(route.Paths[i+1].EndpointA, sdk.NewInt64Coin(route.Denom(i+1, sdk.DefaultBondDenom), 0))
+		pfmtesting.AssertNoInFlightPackets(chain)
+	}
+}
+
+func TestForward_TimeoutRetry(t *testing.T) {
+	coord := pfmtesting.NewCoordinator(t, 3)
+	route := coord.SetupRoute(coord.Chains...)
+	chainB, chainC := route.Chain(1), route.Chain(2)
+
+	receiver := chainC.SenderAccounts[1].SenderAccount.GetAddress()
+
+	packet := route.Forward(sdk.NewCoin(sdk.DefaultBondDenom, amount), receiver.String(), 1, 10*time.Minute)
+	packets := route.RelayHops(packet, 1)
+	require.Len(t, pfmtesting.GetInFlightPackets(chainB), 1)
+
+	// the forward from B to C times out and is retried once.
+	result := route.Timeout(1, packets[1])
+	require.Nil(t, result.Ack)
+	require.NotNil(t, result.Packet)
+	require.Greater(t, result.Packet.Sequence, packets[1].Sequence)
+	require.Len(t, pfmtesting.GetInFlightPackets(chainB), 1)
+
+	relayed, err := pfmtesting.RelayPacket(route.Paths[1], *result.Packet)
+	require.NoError(t, err)
+	require.NotNil(t, relayed.Ack)
+	requireAck(t, route.RelayAcks([]channeltypes.Packet{packets[0], *result.Packet}, relayed.Ack), true)
+
+	pfmtesting.AssertBalance(chainC, receiver, sdk.NewCoin(route.Denom(2, sdk.DefaultBondDenom), amount))
+	pfmtesting.AssertNoInFlightPackets(chainB)
+	pfmtesting.AssertEscrow(route.Paths[0].EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, amount))
+}
+
+func TestForward_TimeoutRefund(t *testing.T) {
+	coord := pfmtesting.NewCoordinator(t, 3)
+	route := coord.SetupRoute(coord.Chains...)
+	chainA, chainB := route.Chain(0), route.Chain(1)
+
+	sender := chainA.SenderAccount.GetAddress()
+	receiver := route.Chain(2).SenderAccounts[1].SenderAccount.GetAddress()
+	balance := pfmtesting.GetBalance(chainA, sender, sdk.DefaultBondDenom)
+
+	packet := route.Forward(sdk.NewCoin(sdk.DefaultBondDenom, amount), receiver.String(), 0, 10*time.Minute)
+	packets := route.RelayHops(packet, 1)
+
+	// without retries, B acknowledges the packet from A with an error once its forward times out.
+	result := route.Timeout(1, packets[1])
+	require.Nil(t, result.Packet)
+	require.NotNil(t, result.Ack)
+	requireAck(t, route.RelayAcks(packets[:1], result.Ack), false)
+
+	pfmtesting.AssertBalance(chainA, sender, balance)
+	pfmtesting.AssertEscrow(route.Paths[0].EndpointA, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0))
+	pfmtesting.AssertNoInFlightPackets(chainB)
+}
+
+func TestForward_RetriesAndTimeoutClamped(t *testing.T) {
+	coord := pfmtesting.NewCoordinator(t, 3)
+	route := coord.SetupRoute(coord.Chains...)
+	chainA, chainB := route.Chain(0), route.Chain(1)
+
+	pfmtesting.UpdateParams(chainB, func(params *types.Params) {
+		params.MaxRetries = 0
+		params.MaxForwardTimeout = time.Hour
+	})
+
+	sender := chainA.SenderAccount.GetAddress()
+	receiver := route.Chain(2).SenderAccounts[1].SenderAccount.GetAddress()
+	balance := pfmtesting.GetBalance(chainA, sender, sdk.DefaultBondDenom)
+
+	// the retries and timeout of the memo exceed the bounds of B, so they are clamped.
+	packet := route.Forward(sdk.NewCoin(sdk.DefaultBondDenom, amount), receiver.String(), 3, 24*time.Hour)
+	packets := route.RelayHops(packet, 1)
+
+	inFlightPackets := pfmtesting.GetInFlightPackets(chainB)
+	require.Len(t, inFlightPackets, 1)
+	for _, inFlightPacket := range inFlightPackets {
+		require.Equal(t, int32(0), inFlightPacket.RetriesRemaining)
+		require.Equal(t, uint64(time.Hour), inFlightPacket.Timeout)
+	}
+
+	// without retries, the timed out forward is refunded.
+	result := route.Timeout(1, packets[1])
+	require.Nil(t, result.Packet)
+	requireAck(t, route.RelayAcks(packets[:1], result.Ack), false)
+	pfmtesting.AssertBalance(chainA, sender, balance)
+}
+
+func TestForward_Receipts(t *testing.T) {
+	coord := pfmtesting.NewCoordinator(t, 3)
+	route := coord.SetupRoute(coord.Chains...)
+	chainA, chainB := route.Chain(0), route.Chain(1)
+
+	pfmtesting.UpdateParams(chainB, func(params *types.Params) {
+		params.FeePercentage = sdk.NewDecWithPrec(10, 2)
+		params.ReceiptRetentionBlocks = 100
+	})
+	pfmKeeper := pfmtesting.GetSimApp(chainB).PacketForwardKeeper
+
+	sender := chainA.SenderAccount.GetAddress().String()
+	receiver := route.Chain(2).SenderAccounts[1].SenderAccount.GetAddress()
+	denom := route.Denom(1, sdk.DefaultBondDenom)
+	fee := sdk.NewCoin(denom, amount.QuoRaw(10))
+
+	packet := route.Forward(sdk.NewCoin(sdk.DefaultBondDenom, amount), receiver.String(), 0, 0)
+	requireAck(t, route.Relay(packet), true)
+
+	receipt, found := pfmKeeper.GetReceipt(chainB.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	require.True(t, found)
+	require.Equal(t, types.ForwardOutcomeSuccess, receipt.Outcome)
+	require.Equal(t, sender, receipt.OriginalSender)
+	require.Equal(t, route.Paths[1].EndpointA.ChannelID, receipt.ForwardChannelId)
+	require.Equal(t, sdk.NewCoin(denom, amount), receipt.Token)
+	require.Equal(t, sdk.NewCoin(denom, amount).Sub(fee), receipt.ForwardToken)
+	require.Equal(t, sdk.NewCoins(fee), receipt.Fees)
+	require.Empty(t, receipt.Error)
+
+	// the last chain cannot credit an invalid receiver and acknowledges with an error.
+	packet = route.Forward(sdk.NewCoin(sdk.DefaultBondDenom, amount), "invalid", 0, 0)
+	requireAck(t, route.Relay(packet), false)
+
+	receipt, found = pfmKeeper.GetReceipt(chainB.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	require.True(t, found)
+	require.Equal(t, types.ForwardOutcomeRefunded, receipt.Outcome)
+	require.NotEmpty(t, receipt.Error)
+
+	res, err := pfmKeeper.ReceiptsBySender(sdk.WrapSDKContext(chainB.GetContext()), &types.QueryReceiptsBySenderRequest{
+		OriginalSender: sender,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Receipts, 2)
+}
+
+func TestForward_FeeSummary(t *testing.T) {
+	coord := pfmtesting.NewCoordinator(t, 4)
+	route := coord.SetupRoute(coord.Chains...)
+	for _, chain := range route.Chains()[1:3] {
+		pfmtesting.UpdateParams(chain, func(params *types.Params) {
+			params.FeePercentage = sdk.NewDecWithPrec(10, 2)
+		})
+	}
+
+	receiver := route.Chain(3).SenderAccounts[1].SenderAccount.GetAddress()
+
+	// fee summaries are disabled by default, so the result of the last chain is passed back unchanged.
+	packet := route.Forward(sdk.NewCoin(sdk.DefaultBondDenom, amount), receiver.String(), 0, 0)
+	bz := route.Relay(packet)
+	requireAck(t, bz, true)
+
+	var ack channeltypes.Acknowledgement
+	require.NoError(t, transfertypes.ModuleCdc.UnmarshalJSON(bz, &ack))
+	require.Equal(t, []byte{byte(1)}, ack.GetResult())
+
+	for _, chain := range route.Chains()[1:3] {
+		pfmtesting.UpdateParams(chain, func(params *types.Params) {
+			params.FeeSummaryAcks = true
+		})
+	}
+
+	packet = route.Forward(sdk.NewCoin(sdk.DefaultBondDenom, amount), receiver.String(), 0, 0)
+	bz = route.Relay(packet)
+	requireAck(t, bz, true)
+
+	require.NoError(t, transfertypes.ModuleCdc.UnmarshalJSON(bz, &ack))
+	summary, ok := types.ParseFeeSummary(ack.GetResult())
+	require.True(t, ok, "acknowledgement result %s is not a fee summary", ack.GetResult())
+
+	// the result of the last chain is kept, and the fees of every forwarding chain are in the order of the route.
+	require.Equal(t, []byte{byte(1)}, summary.Result)
+	bFee := sdk.NewCoin(route.Denom(1, sdk.DefaultBondDenom), amount.QuoRaw(10))
+	cFee := sdk.NewCoin(route.Denom(2, sdk.DefaultBondDenom), amount.Sub(bFee.Amount).QuoRaw(10))
+	require.Equal(t, []types.HopFees{
+		{PortID: route.Paths[0].EndpointB.ChannelConfig.PortID, ChannelID: route.Paths[0].EndpointB.ChannelID, Fees: sdk.NewCoins(bFee)},
+		{PortID: route.Paths[1].EndpointB.ChannelConfig.PortID, ChannelID: route.Paths[1].EndpointB.ChannelID, Fees: sdk.NewCoins(cFee)},
+	}, summary.Hops)
+}
@@ -0,0 +1,101 @@
+package ibctesting_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	pfmtesting "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/testing"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+)
+
+// recordingHooks records the calls of the forward hooks. It fails every call after writing to the store if err
+// is set.
+type recordingHooks struct {
+	calls []string
+	err   error
+}
+
+var _ types.ForwardHooks = &recordingHooks{}
+
+func (h *recordingHooks) record(ctx sdk.Context, hook, portID, channelID string, sequence uint64) error {
+	h.calls = append(h.calls, fmt.Sprintf("%s %s/%s/%d", hook, portID, channelID, sequence))
+	ctx.EventManager().EmitEvent(sdk.NewEvent(hook))
+	return h.err
+}
+
+func (h *recordingHooks) AfterForwardInitiated(ctx sdk.Context, portID, channelID string, sequence uint64, _ types.InFlightPacket) error {
+	return h.record(ctx, "initiated", portID, channelID, sequence)
+}
+
+func (h *recordingHooks) AfterForwardAcked(ctx sdk.Context, portID, channelID string, sequence uint64, _ types.InFlightPacket) error {
+	return h.record(ctx, "acked", portID, channelID, sequence)
+}
+
+func (h *recordingHooks) AfterForwardRefunded(ctx sdk.Context, portID, channelID string, sequence uint64, _ types.InFlightPacket, _ string) error {
+	return h.record(ctx, "refunded", portID, channelID, sequence)
+}
+
+func (h *recordingHooks) AfterForwardRecovered(ctx sdk.Context, portID, channelID string, sequence uint64, _ types.InFlightPacket, _ string) error {
+	return h.record(ctx, "recovered", portID, channelID, sequence)
+}
+
+func TestForwardHooks(t *testing.T) {
+	coord := pfmtesting.NewCoordinator(t, 3)
+	route := coord.SetupRoute(coord.Chains...)
+	chainB := route.Chain(1)
+
+	hooks := &recordingHooks{}
+	pfmKeeper := pfmtesting.GetSimApp(chainB).PacketForwardKeeper
+	pfmKeeper.SetHooks(types.NewMultiForwardHooks(hooks))
+	require.Panics(t, func() { pfmKeeper.SetHooks(hooks) })
+
+	receiver := route.Chain(2).SenderAccounts[1].SenderAccount.GetAddress()
+	forward := route.Paths[1].EndpointA
+
+	packet := route.Forward(sdk.NewCoin(sdk.DefaultBondDenom, amount), receiver.String(), 0, 0)
+	requireAck(t, route.Relay(packet), true)
+
+	// the last chain cannot credit an invalid receiver and acknowledges with an error.
+	packet = route.Forward(sdk.NewCoin(sdk.DefaultBondDenom, amount), "invalid", 0, 0)
+	requireAck(t, route.Relay(packet), false)
+
+	require.Equal(t, []string{
+		fmt.Sprintf("initiated %s/%s/1", forward.ChannelConfig.PortID, forward.ChannelID),
+		fmt.Sprintf("acked %s/%s/1", forward.ChannelConfig.PortID, forward.ChannelID),
+		fmt.Sprintf("initiated %s/%s/2", forward.ChannelConfig.PortID, forward.ChannelID),
+		fmt.Sprintf("refunded %s/%s/2", forward.ChannelConfig.PortID, forward.ChannelID),
+	}, hooks.calls)
+}
+
+func TestForwardHooks_Error(t *testing.T) {
+	coord := pfmtesting.NewCoordinator(t, 3)
+	route := coord.SetupRoute(coord.Chains...)
+	chainB, chainC := route.Chain(1), route.Chain(2)
+
+	hooks := &recordingHooks{err: errors.New("hook failed")}
+	pfmtesting.GetSimApp(chainB).PacketForwardKeeper.SetHooks(hooks)
+
+	receiver := chainC.SenderAccounts[1].SenderAccount.GetAddress()
+
+	// failing hooks do not fail the forward, and their events are discarded.
+	packet := route.Forward(sdk.NewCoin(sdk.DefaultBondDenom, amount), receiver.String(), 0, 0)
+	result, err := pfmtesting.RelayPacket(route.Paths[0], packet)
+	require.NoError(t, err)
+	require.NotNil(t, result.Packet)
+	for _, event := range result.Events {
+		require.NotEqual(t, "initiated", event.Type)
+	}
+
+	relayed, err := pfmtesting.RelayPacket(route.Paths[1], *result.Packet)
+	require.NoError(t, err)
+	requireAck(t, route.RelayAcks([]channeltypes.Packet{packet, *result.Packet}, relayed.Ack), true)
+
+	require.Len(t, hooks.calls, 2)
+	pfmtesting.AssertBalance(chainC, receiver, sdk.NewCoin(route.Denom(2, sdk.DefaultBondDenom), amount))
+}
@@ -0,0 +1,159 @@
+package ibctesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	pfmtesting "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/testing"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+// writtenAcks returns the acknowledgements written in the events, in the order they were written.
+func writtenAcks(t *testing.T, events sdk.Events) [][]byte {
+	t.Helper()
+
+	var acks [][]byte
+	for _, event := range events {
+		if event.Type != channeltypes.EventTypeWriteAck {
+			continue
+		}
+		ack, err := ibctesting.ParseAckFromEvents(sdk.Events{event})
+		require.NoError(t, err)
+		acks = append(acks, ack)
+	}
+	return acks
+}
+
+func TestForward_TimeoutUnorderedChannelRetries(t *testing.T) {
+	coord := pfmtesting.NewCoordinator(t, 3)
+	route := coord.SetupRoute(coord.Chains...)
+	chainB, chainC := route.Chain(1), route.Chain(2)
+	require.NoError(t, pfmtesting.SetChannelOrder(route.Paths[1], channeltypes.UNORDERED))
+
+	receiver := chainC.SenderAccounts[1].SenderAccount.GetAddress()
+
+	packet := route.Forward(sdk.NewCoin(sdk.DefaultBondDenom, amount), receiver.String(), 1, 10*time.Minute)
+	packets := route.RelayHops(packet, 1)
+
+	// the unordered channel stays open after the timeout, so the forward is retried over it.
+	result := route.Timeout(1, packets[1])
+	require.Nil(t, result.Ack)
+	require.NotNil(t, result.Packet)
+	require.Equal(t, channeltypes.OPEN, route.Paths[1].EndpointA.GetChannel().State)
+
+	relayed, err := pfmtesting.RelayPacket(route.Paths[1], *result.Packet)
+	require.NoError(t, err)
+	result, err = pfmtesting.RelayAck(route.Paths[1], *result.Packet, relayed.Ack)
+	require.NoError(t, err)
+	requireAck(t, route.RelayAcks(packets[:1], result.Ack), true)
+
+	pfmtesting.AssertBalance(chainC, receiver, sdk.NewCoin(route.Denom(2, sdk.DefaultBondDenom), amount))
+	pfmtesting.AssertNoInFlightPackets(chainB)
+}
+
+func TestForward_TimeoutOrderedChannelRefunds(t *testing.T) {
+	coord := pfmtesting.NewCoordinator(t, 3)
+	route := coord.SetupRoute(coord.Chains...)
+	chainA, chainB, chainC := route.Chain(0), route.Chain(1), route.Chain(2)
+	require.NoError(t, pfmtesting.SetChannelOrder(route.Paths[1], channeltypes.ORDERED))
+
+	sender := chainA.SenderAccount.GetAddress()
+	receiver := chainC.SenderAccounts[1].SenderAccount.GetAddress()
+	balance := pfmtesting.GetBalance(chainA, sender, sdk.DefaultBondDenom)
+
+	first := route.Forward(sdk.NewCoin(sdk.DefaultBondDenom, amount), receiver.String(), 1, 10*time.Minute)
+	firstPackets := route.RelayHops(first, 1)
+	second := route.Forward(sdk.NewCoin(sdk.DefaultBondDenom, amount), receiver.String(), 1, 10*time.Minute)
+	secondPackets := route.RelayHops(second, 1)
+
+	// the timeout closes the ordered channel, so the forward is refunded instead of retried, and so is the later
+	// forward over the channel.
+	result := route.Timeout(1, firstPackets[1])
+	require.Nil(t, result.Packet)
+	requireEvent(t, result.Events, types.EventTypeInFlightPacketResolved)
+	require.Equal(t, channeltypes.CLOSED, route.Paths[1].EndpointA.GetChannel().State)
+
+	acks := writtenAcks(t, result.Events)
+	require.Len(t, acks, 2)
+	requireAck(t, route.RelayAcks(firstPackets[:1], acks[0]), false)
+	requireAck(t, route.RelayAcks(secondPackets[:1], acks[1]), false)
+	pfmtesting.AssertBalance(chainA, sender, balance)
+
+	// the other forward stays resolved until it is timed out on close.
+	inFlightPackets := pfmtesting.GetInFlightPackets(chainB)
+	require.Len(t, inFlightPackets, 1)
+	for _, inFlightPacket := range inFlightPackets {
+		require.True(t, inFlightPacket.Resolved)
+	}
+}
+
+func TestForward_TimeoutOrderedChannelKeepsReceivedForwards(t *testing.T) {
+	coord := pfmtesting.NewCoordinator(t, 3)
+	route := coord.SetupRoute(coord.Chains...)
+	chainA, chainB, chainC := route.Chain(0), route.Chain(1), route.Chain(2)
+	require.NoError(t, pfmtesting.SetChannelOrder(route.Paths[1], channeltypes.ORDERED))
+
+	sender := chainA.SenderAccount.GetAddress()
+	receiver := chainC.SenderAccounts[1].SenderAccount.GetAddress()
+	balance := pfmtesting.GetBalance(chainA, sender, sdk.DefaultBondDenom)
+
+	first := route.Forward(sdk.NewCoin(sdk.DefaultBondDenom, amount), receiver.String(), 1, 10*time.Minute)
+	firstPackets := route.RelayHops(first, 1)
+	second := route.Forward(sdk.NewCoin(sdk.DefaultBondDenom, amount), receiver.String(), 1, 10*time.Minute)
+	secondPackets := route.RelayHops(second, 1)
+
+	// C receives the first forward, but its acknowledgement is not relayed before the second forward times out.
+	_, err := pfmtesting.RelayPacket(route.Paths[1], firstPackets[1])
+	require.NoError(t, err)
+
+	// the timeout of the second forward proves that the first forward was received, so only the second forward is
+	// refunded.
+	result := route.Timeout(1, secondPackets[1])
+	require.Nil(t, result.Packet)
+	require.Equal(t, channeltypes.CLOSED, route.Paths[1].EndpointA.GetChannel().State)
+
+	acks := writtenAcks(t, result.Events)
+	require.Len(t, acks, 1)
+	requireAck(t, route.RelayAcks(secondPackets[:1], acks[0]), false)
+	pfmtesting.AssertBalance(chainA, sender, balance.SubAmount(amount))
+	pfmtesting.AssertBalance(chainC, receiver, sdk.NewCoin(route.Denom(2, sdk.DefaultBondDenom), amount))
+
+	inFlightPackets := pfmtesting.GetInFlightPackets(chainB)
+	require.Len(t, inFlightPackets, 1)
+	for _, inFlightPacket := range inFlightPackets {
+		require.False(t, inFlightPacket.Resolved)
+	}
+}
+
+func TestForward_TimeoutOrderedReceiveChannelRecoversRefund(t *testing.T) {
+	coord := pfmtesting.NewCoordinator(t, 2)
+	path := coord.SetupRoute(coord.Chains...).Paths[0]
+	chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+	require.NoError(t, pfmtesting.SetChannelOrder(path, channeltypes.ORDERED))
+
+	// the forward is sent back over the ordered channel it was received on.
+	route := &pfmtesting.Route{Paths: []*ibctesting.Path{path, reversePath(path)}}
+	sender := chainA.SenderAccount.GetAddress()
+	receiver := chainA.SenderAccounts[1].SenderAccount.GetAddress()
+
+	packet := route.Forward(sdk.NewCoin(sdk.DefaultBondDenom, amount), receiver.String(), 0, 10*time.Minute)
+	packets := route.RelayHops(packet, 1)
+
+	// the timeout closes the channel, so neither the error acknowledgement nor a refund packet can be sent over
+	// it and the refund is moved to the account of the sender on B.
+	result := route.Timeout(1, packets[1])
+	require.Nil(t, result.Packet)
+	require.Empty(t, writtenAcks(t, result.Events))
+	requireEvent(t, result.Events, types.EventTypeRefundRecovered)
+	require.Equal(t, channeltypes.CLOSED, path.EndpointB.GetChannel().State)
+
+	require.Empty(t, pfmtesting.GetPendingRefunds(chainB))
+	pfmtesting.AssertNoInFlightPackets(chainB)
+	pfmtesting.AssertBalance(chainB, sender, sdk.NewCoin(route.Denom(1, sdk.DefaultBondDenom), amount))
+}
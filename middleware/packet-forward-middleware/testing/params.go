@@ -0,0 +1,17 @@
+package ibctesting
+
+import (
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	"github.com/stretchr/testify/require"
+
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+// UpdateParams updates the parameters of the packetforward module on the chain.
+func UpdateParams(chain *ibctesting.TestChain, update func(params *types.Params)) {
+	pfmKeeper := GetSimApp(chain).PacketForwardKeeper
+
+	params := pfmKeeper.GetParams(chain.GetContext())
+	update(&params)
+	require.NoError(chain.T, pfmKeeper.SetParams(chain.GetContext(), params))
+}
@@ -0,0 +1,125 @@
+package ibctesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	pfmtesting "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/testing"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+// requireEvent asserts that the events include an event of the type.
+func requireEvent(t *testing.T, events sdk.Events, eventType string) {
+	t.Helper()
+
+	for _, event := range events {
+		if event.Type == eventType {
+			return
+		}
+	}
+	require.Failf(t, "missing event", "no %s event", eventType)
+}
+
+func TestForward_RefundPacketOnClosedChannel(t *testing.T) {
+	coord := pfmtesting.NewCoordinator(t, 3)
+	route := coord.SetupRoute(coord.Chains...)
+	chainA, chainB := route.Chain(0), route.Chain(1)
+
+	// the refund fails over from the channel the forward was received on to an equivalent channel. Its timeout
+	// is shortened, so that it times out before the clients expire.
+	alternatePath := coord.SetupTransferPath(chainA, chainB)
+	pfmtesting.UpdateParams(chainB, func(params *types.Params) {
+		params.RefundTimeout = time.Hour
+		params.ChannelEquivalences = []types.ChannelEquivalence{
+			types.NewChannelEquivalence(transfertypes.PortID, route.Paths[0].EndpointB.ChannelID, alternatePath.EndpointB.ChannelID),
+		}
+	})
+	alternateReverse := reversePath(alternatePath)
+
+	// the native token of B is sent to A, so that B unescrows it when A forwards it.
+	sender := chainA.SenderAccount.GetAddress()
+	reverse := &pfmtesting.Route{Paths: []*ibctesting.Path{reversePath(route.Paths[0])}}
+	requireAck(t, reverse.Relay(reverse.Forward(sdk.NewCoin(sdk.DefaultBondDenom, amount), sender.String(), 0, 0)), true)
+	denom := reverse.Denom(1, sdk.DefaultBondDenom)
+
+	// the last chain cannot credit an invalid receiver and acknowledges with an error.
+	packet := route.Forward(sdk.NewCoin(denom, amount), "invalid", 0, 0)
+	packets := route.RelayHops(packet, 1)
+	relayed, err := pfmtesting.RelayPacket(route.Paths[1], packets[1])
+	require.NoError(t, err)
+	require.NotNil(t, relayed.Ack)
+
+	// the error acknowledgement cannot be written once the channel the packet was received on is closed, so the
+	// forward is refunded in a refund packet instead.
+	require.NoError(t, route.Paths[0].EndpointB.SetChannelState(channeltypes.CLOSED))
+	result, err := pfmtesting.RelayAck(route.Paths[1], packets[1], relayed.Ack)
+	require.NoError(t, err)
+	require.Nil(t, result.Ack)
+	require.NotNil(t, result.Packet)
+	require.Equal(t, alternatePath.EndpointB.ChannelID, result.Packet.SourceChannel)
+	requireEvent(t, result.Events, types.EventTypeRefundPacket)
+	pfmtesting.AssertNoInFlightPackets(chainB)
+
+	refunds := pfmtesting.GetPendingRefunds(chainB)
+	require.Len(t, refunds, 1)
+	require.Equal(t, result.Packet.Sequence, refunds[0].Sequence)
+	require.Equal(t, packet.Sequence, refunds[0].RefundSequence)
+	require.NotEmpty(t, refunds[0].Error)
+	// the closed channel the packet was received on is not attempted.
+	require.Equal(t, []string{alternatePath.EndpointB.ChannelID}, refunds[0].AttemptedChannelIds)
+
+	// the timed out refund packet is retried.
+	result, err = pfmtesting.RelayTimeout(alternateReverse, *result.Packet)
+	require.NoError(t, err)
+	require.NotNil(t, result.Packet)
+	refunds = pfmtesting.GetPendingRefunds(chainB)
+	require.Len(t, refunds, 1)
+	require.Equal(t, result.Packet.Sequence, refunds[0].Sequence)
+	require.Equal(t, int32(types.DefaultMaxRetries-1), refunds[0].RetriesRemaining)
+
+	relayed, err = pfmtesting.RelayPacket(alternateReverse, *result.Packet)
+	require.NoError(t, err)
+	requireAck(t, relayed.Ack, true)
+	_, err = pfmtesting.RelayAck(alternateReverse, *result.Packet, relayed.Ack)
+	require.NoError(t, err)
+
+	require.Empty(t, pfmtesting.GetPendingRefunds(chainB))
+	refundDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		alternatePath.EndpointA.ChannelConfig.PortID, alternatePath.EndpointA.ChannelID, sdk.DefaultBondDenom,
+	)).IBCDenom()
+	pfmtesting.AssertBalance(chainA, sender, sdk.NewCoin(refundDenom, amount))
+}
+
+func TestForward_RefundRecoveredOnClosedChannel(t *testing.T) {
+	coord := pfmtesting.NewCoordinator(t, 3)
+	route := coord.SetupRoute(coord.Chains...)
+	chainA, chainB := route.Chain(0), route.Chain(1)
+
+	sender := chainA.SenderAccount.GetAddress()
+
+	// the last chain cannot credit an invalid receiver and acknowledges with an error.
+	packet := route.Forward(sdk.NewCoin(sdk.DefaultBondDenom, amount), "invalid", 0, 0)
+	packets := route.RelayHops(packet, 1)
+	relayed, err := pfmtesting.RelayPacket(route.Paths[1], packets[1])
+	require.NoError(t, err)
+	require.NotNil(t, relayed.Ack)
+
+	// the refund cannot be sent over the closed channel, so it is moved to the account of the sender on B.
+	require.NoError(t, route.Paths[0].EndpointB.SetChannelState(channeltypes.CLOSED))
+	result, err := pfmtesting.RelayAck(route.Paths[1], packets[1], relayed.Ack)
+	require.NoError(t, err)
+	require.Nil(t, result.Ack)
+	require.Nil(t, result.Packet)
+	requireEvent(t, result.Events, types.EventTypeRefundRecovered)
+
+	require.Empty(t, pfmtesting.GetPendingRefunds(chainB))
+	pfmtesting.AssertNoInFlightPackets(chainB)
+	pfmtesting.AssertBalance(chainB, sender, sdk.NewCoin(route.Denom(1, sdk.DefaultBondDenom), amount))
+}
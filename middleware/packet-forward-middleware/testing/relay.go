@@ -0,0 +1,182 @@
+package ibctesting
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+// RelayResult is the result of delivering a packet, an acknowledgement or a timeout to a chain.
+type RelayResult struct {
+	// Ack is the acknowledgement written by the chain, if any. A forwarding chain writes the acknowledgement
+	// of the packet it received once the forwarded packet is acknowledged or timed out.
+	Ack []byte
+	// Packet is the packet sent by the chain, if any, such as a forwarded or retried packet.
+	Packet *channeltypes.Packet
+	// Events are the events emitted by the chain.
+	Events sdk.Events
+}
+
+// RelayPacket receives the packet, sent over the path from endpoint A, on endpoint B.
+func RelayPacket(path *ibctesting.Path, packet channeltypes.Packet) (RelayResult, error) {
+	if err := path.EndpointB.UpdateClient(); err != nil {
+		return RelayResult{}, err
+	}
+
+	res, err := path.EndpointB.RecvPacketWithResult(packet)
+	if err != nil {
+		return RelayResult{}, err
+	}
+	return parseRelayResult(res)
+}
+
+// RelayAck acknowledges the packet, sent over the path from endpoint A, with the acknowledgement written on
+// endpoint B.
+func RelayAck(path *ibctesting.Path, packet channeltypes.Packet, ack []byte) (RelayResult, error) {
+	if err := path.EndpointA.UpdateClient(); err != nil {
+		return RelayResult{}, err
+	}
+
+	packetKey := host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	proof, proofHeight := path.EndpointB.QueryProof(packetKey)
+
+	msg := channeltypes.NewMsgAcknowledgement(packet, ack, proof, proofHeight, path.EndpointA.Chain.SenderAccount.GetAddress().String())
+	res, err := path.EndpointA.Chain.SendMsgs(msg)
+	if err != nil {
+		return RelayResult{}, err
+	}
+	return parseRelayResult(res)
+}
+
+// RelayTimeout times out the packet, sent over the path from endpoint A, on endpoint A. The time of all chains
+// is advanced past the timeout of the packet first if it has not passed on endpoint B yet.
+func RelayTimeout(path *ibctesting.Path, packet channeltypes.Packet) (RelayResult, error) {
+	chainB := path.EndpointB.Chain
+	if timeout := time.Unix(0, int64(packet.GetTimeoutTimestamp())); packet.GetTimeoutTimestamp() != 0 &&
+		!chainB.CurrentHeader.Time.After(timeout) {
+		chainB.Coordinator.IncrementTimeBy(timeout.Sub(chainB.CurrentHeader.Time) + time.Nanosecond)
+	}
+	if height := packet.GetTimeoutHeight(); !height.IsZero() {
+		for !clienttypes.GetSelfHeight(chainB.GetContext()).GT(height) {
+			chainB.NextBlock()
+		}
+	}
+	chainB.Coordinator.CommitBlock(chainB)
+
+	if err := path.EndpointA.UpdateClient(); err != nil {
+		return RelayResult{}, err
+	}
+
+	var packetKey []byte
+	switch path.EndpointA.ChannelConfig.Order {
+	case channeltypes.ORDERED:
+		packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
+	case channeltypes.UNORDERED:
+		packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	default:
+		return RelayResult{}, fmt.Errorf("unsupported order type %s", path.EndpointA.ChannelConfig.Order)
+	}
+	proof, proofHeight := path.EndpointB.QueryProof(packetKey)
+
+	nextSeqRecv, found := chainB.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(
+		chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(),
+	)
+	if !found {
+		return RelayResult{}, fmt.Errorf("next sequence receive not found for port %s channel %s", packet.GetDestPort(), packet.GetDestChannel())
+	}
+
+	msg := channeltypes.NewMsgTimeout(packet, nextSeqRecv, proof, proofHeight, path.EndpointA.Chain.SenderAccount.GetAddress().String())
+	res, err := path.EndpointA.Chain.SendMsgs(msg)
+	if err != nil {
+		return RelayResult{}, err
+	}
+	return parseRelayResult(res)
+}
+
+// CloseChannel closes the channel of the path on endpoint B and confirms the close on endpoint A. The transfer
+// module does not allow channels to be closed by a message, so the state is set directly on endpoint B.
+func CloseChannel(path *ibctesting.Path) (RelayResult, error) {
+	if err := path.EndpointB.SetChannelState(channeltypes.CLOSED); err != nil {
+		return RelayResult{}, err
+	}
+	if err := path.EndpointA.UpdateClient(); err != nil {
+		return RelayResult{}, err
+	}
+
+	proof, proofHeight := path.EndpointB.QueryProof(host.ChannelKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+	msg := channeltypes.NewMsgChannelCloseConfirm(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		proof, proofHeight, path.EndpointA.Chain.SenderAccount.GetAddress().String(),
+	)
+	res, err := path.EndpointA.Chain.SendMsgs(msg)
+	if err != nil {
+		return RelayResult{}, err
+	}
+	return parseRelayResult(res)
+}
+
+// RelayTimeoutOnClose times out the packet, sent over the path from endpoint A, on endpoint A once the channel
+// is closed on endpoint B. Endpoint B must not have received the packet.
+func RelayTimeoutOnClose(path *ibctesting.Path, packet channeltypes.Packet) (RelayResult, error) {
+	if err := path.EndpointA.UpdateClient(); err != nil {
+		return RelayResult{}, err
+	}
+
+	var packetKey []byte
+	switch path.EndpointA.ChannelConfig.Order {
+	case channeltypes.ORDERED:
+		packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
+	case channeltypes.UNORDERED:
+		packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	default:
+		return RelayResult{}, fmt.Errorf("unsupported order type %s", path.EndpointA.ChannelConfig.Order)
+	}
+	proof, proofHeight := path.EndpointB.QueryProof(packetKey)
+	proofClosed, _ := path.EndpointB.QueryProof(host.ChannelKey(packet.GetDestPort(), packet.GetDestChannel()))
+
+	chainB := path.EndpointB.Chain
+	nextSeqRecv, found := chainB.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(
+		chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(),
+	)
+	if !found {
+		return RelayResult{}, fmt.Errorf("next sequence receive not found for port %s channel %s", packet.GetDestPort(), packet.GetDestChannel())
+	}
+
+	msg := channeltypes.NewMsgTimeoutOnClose(packet, nextSeqRecv, proof, proofClosed, proofHeight, path.EndpointA.Chain.SenderAccount.GetAddress().String())
+	res, err := path.EndpointA.Chain.SendMsgs(msg)
+	if err != nil {
+		return RelayResult{}, err
+	}
+	return parseRelayResult(res)
+}
+
+// parseRelayResult parses the acknowledgement written and the packet sent from the events of a result.
+func parseRelayResult(res *sdk.Result) (RelayResult, error) {
+	result := RelayResult{Events: res.GetEvents()}
+
+	for _, event := range result.Events {
+		switch event.Type {
+		case channeltypes.EventTypeWriteAck:
+			ack, err := ibctesting.ParseAckFromEvents(sdk.Events{event})
+			if err != nil {
+				return RelayResult{}, err
+			}
+			result.Ack = ack
+
+		case channeltypes.EventTypeSendPacket:
+			packet, err := ibctesting.ParsePacketFromEvents(sdk.Events{event})
+			if err != nil {
+				return RelayResult{}, err
+			}
+			result.Packet = &packet
+		}
+	}
+
+	return result, nil
+}
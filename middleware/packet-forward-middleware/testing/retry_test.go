@@ -0,0 +1,157 @@
+package ibctesting_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	pfmtesting "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/testing"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+// receiveDisabledCode is the ABCI code of the error acknowledgement of a transfer to a chain that has disabled
+// receiving transfers.
+var receiveDisabledCode = func() uint32 {
+	_, code, _ := errorsmod.ABCIInfo(transfertypes.ErrReceiveDisabled, false)
+	return code
+}()
+
+// setReceiveEnabled enables or disables receiving transfers on the chain.
+func setReceiveEnabled(chain *ibctesting.TestChain, enabled bool) {
+	transferKeeper := pfmtesting.GetSimApp(chain).TransferKeeper
+	params := transferKeeper.GetParams(chain.GetContext())
+	params.ReceiveEnabled = enabled
+	transferKeeper.SetParams(chain.GetContext(), params)
+}
+
+// retryMemo returns the memo of a transfer that is forwarded over the second path of the route with the retry
+// policy.
+func retryMemo(t *testing.T, route *pfmtesting.Route, receiver string, retries uint8, policy *types.RetryPolicy) string {
+	t.Helper()
+
+	bz, err := json.Marshal(types.PacketMetadata{Forward: &types.ForwardMetadata{
+		Receiver:    receiver,
+		Port:        route.Paths[1].EndpointA.ChannelConfig.PortID,
+		Channel:     route.Paths[1].EndpointA.ChannelID,
+		Retries:     &retries,
+		RetryPolicy: policy,
+	}})
+	require.NoError(t, err)
+	return string(bz)
+}
+
+func TestForward_ErrorAckRetryAlternateChannel(t *testing.T) {
+	coord := pfmtesting.NewCoordinator(t, 3)
+	route := coord.SetupRoute(coord.Chains...)
+	chainB, chainC := route.Chain(1), route.Chain(2)
+	alternatePath := coord.SetupTransferPath(chainB, chainC)
+
+	receiver := chainC.SenderAccounts[1].SenderAccount.GetAddress()
+	setReceiveEnabled(chainC, false)
+
+	memo := retryMemo(t, route, receiver.String(), 1, &types.RetryPolicy{
+		ErrorCodes:       []uint32{receiveDisabledCode},
+		AlternateChannel: alternatePath.EndpointA.ChannelID,
+	})
+	packet := route.Transfer(sdk.NewCoin(sdk.DefaultBondDenom, amount), types.IntermediateReceiver, memo)
+	packets := route.RelayHops(packet, 1)
+
+	// C acknowledges the forward with a retriable error, so B retries it over the alternate channel.
+	relayed, err := pfmtesting.RelayPacket(route.Paths[1], packets[1])
+	require.NoError(t, err)
+	requireAck(t, relayed.Ack, false)
+
+	result, err := pfmtesting.RelayAck(route.Paths[1], packets[1], relayed.Ack)
+	require.NoError(t, err)
+	require.Nil(t, result.Ack)
+	require.NotNil(t, result.Packet)
+	require.Equal(t, alternatePath.EndpointA.ChannelID, result.Packet.SourceChannel)
+
+	inFlightPackets := pfmtesting.GetInFlightPackets(chainB)
+	require.Len(t, inFlightPackets, 1)
+	for _, inFlightPacket := range inFlightPackets {
+		require.Equal(t, int32(0), inFlightPacket.RetriesRemaining)
+	}
+
+	// the retry succeeds once C receives transfers again.
+	setReceiveEnabled(chainC, true)
+	relayed, err = pfmtesting.RelayPacket(alternatePath, *result.Packet)
+	require.NoError(t, err)
+	result, err = pfmtesting.RelayAck(alternatePath, *result.Packet, relayed.Ack)
+	require.NoError(t, err)
+	require.NotNil(t, result.Ack)
+	requireAck(t, route.RelayAcks(packets[:1], result.Ack), true)
+
+	denom := transfertypes.GetPrefixedDenom(alternatePath.EndpointB.ChannelConfig.PortID, alternatePath.EndpointB.ChannelID,
+		transfertypes.GetPrefixedDenom(route.Paths[0].EndpointB.ChannelConfig.PortID, route.Paths[0].EndpointB.ChannelID, sdk.DefaultBondDenom))
+	pfmtesting.AssertBalance(chainC, receiver, sdk.NewCoin(transfertypes.ParseDenomTrace(denom).IBCDenom(), amount))
+	pfmtesting.AssertNoInFlightPackets(chainB)
+}
+
+func TestForward_TimeoutRetryIgnoresAlternateChannel(t *testing.T) {
+	coord := pfmtesting.NewCoordinator(t, 3)
+	route := coord.SetupRoute(coord.Chains...)
+	chainB, chainC := route.Chain(1), route.Chain(2)
+	alternatePath := coord.SetupTransferPath(chainB, chainC)
+
+	receiver := chainC.SenderAccounts[1].SenderAccount.GetAddress()
+
+	memo := retryMemo(t, route, receiver.String(), 1, &types.RetryPolicy{
+		ErrorCodes:       []uint32{receiveDisabledCode},
+		AlternateChannel: alternatePath.EndpointA.ChannelID,
+	})
+	packet := route.Transfer(sdk.NewCoin(sdk.DefaultBondDenom, amount), types.IntermediateReceiver, memo)
+	packets := route.RelayHops(packet, 1)
+
+	// the alternate channel only applies to error acknowledgements, so the timeout is retried over the channel
+	// of the forward.
+	result := route.Timeout(1, packets[1])
+	require.Nil(t, result.Ack)
+	require.NotNil(t, result.Packet)
+	require.Equal(t, route.Paths[1].EndpointA.ChannelID, result.Packet.SourceChannel)
+
+	relayed, err := pfmtesting.RelayPacket(route.Paths[1], *result.Packet)
+	require.NoError(t, err)
+	requireAck(t, route.RelayAcks([]channeltypes.Packet{packets[0], *result.Packet}, relayed.Ack), true)
+
+	pfmtesting.AssertBalance(chainC, receiver, sdk.NewCoin(route.Denom(2, sdk.DefaultBondDenom), amount))
+	pfmtesting.AssertNoInFlightPackets(chainB)
+}
+
+func TestForward_ErrorAckNotRetriable(t *testing.T) {
+	coord := pfmtesting.NewCoordinator(t, 3)
+	route := coord.SetupRoute(coord.Chains...)
+	chainA, chainB, chainC := route.Chain(0), route.Chain(1), route.Chain(2)
+
+	sender := chainA.SenderAccount.GetAddress()
+	receiver := chainC.SenderAccounts[1].SenderAccount.GetAddress()
+	balance := pfmtesting.GetBalance(chainA, sender, sdk.DefaultBondDenom)
+	setReceiveEnabled(chainC, false)
+
+	for _, policy := range []*types.RetryPolicy{
+		// the error code is not retriable.
+		{ErrorCodes: []uint32{receiveDisabledCode + 1}},
+		// the retry cannot be sent over an alternate channel that does not exist, so the forward is refunded.
+		{ErrorCodes: []uint32{receiveDisabledCode}, AlternateChannel: "channel-99"},
+	} {
+		memo := retryMemo(t, route, receiver.String(), 1, policy)
+		packet := route.Transfer(sdk.NewCoin(sdk.DefaultBondDenom, amount), types.IntermediateReceiver, memo)
+		requireAck(t, route.Relay(packet), false)
+
+		pfmtesting.AssertBalance(chainA, sender, balance)
+		pfmtesting.AssertNoInFlightPackets(chainB)
+	}
+
+	// without retries left, retriable errors are refunded.
+	memo := retryMemo(t, route, receiver.String(), 0, &types.RetryPolicy{ErrorCodes: []uint32{receiveDisabledCode}})
+	packet := route.Transfer(sdk.NewCoin(sdk.DefaultBondDenom, amount), types.IntermediateReceiver, memo)
+	requireAck(t, route.Relay(packet), false)
+	pfmtesting.AssertBalance(chainA, sender, balance)
+}
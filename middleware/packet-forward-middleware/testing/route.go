@@ -0,0 +1,183 @@
+package ibctesting
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+// DefaultTransferTimeout is the timeout of transfers sent from the first chain of a route.
+const DefaultTransferTimeout = time.Hour
+
+// Route is a sequence of transfer paths over which a transfer is forwarded. Endpoint A of each path is on
+// the chain of endpoint B of the previous path.
+type Route struct {
+	Paths []*ibctesting.Path
+}
+
+// SetupRoute sets up a transfer path between each pair of consecutive chains and returns the route over them.
+func (coord *Coordinator) SetupRoute(chains ...*ibctesting.TestChain) *Route {
+	require.GreaterOrEqual(coord.T, len(chains), 2, "a route needs at least two chains")
+
+	paths := make([]*ibctesting.Path, len(chains)-1)
+	for i := range paths {
+		paths[i] = coord.SetupTransferPath(chains[i], chains[i+1])
+	}
+	return &Route{Paths: paths}
+}
+
+// Chains returns the chains of the route in order.
+func (r *Route) Chains() []*ibctesting.TestChain {
+	chains := make([]*ibctesting.TestChain, 0, len(r.Paths)+1)
+	for _, path := range r.Paths {
+		chains = append(chains, path.EndpointA.Chain)
+	}
+	return append(chains, r.Paths[len(r.Paths)-1].EndpointB.Chain)
+}
+
+// Chain returns the i-th chain of the route.
+func (r *Route) Chain(i int) *ibctesting.TestChain {
+	return r.Chains()[i]
+}
+
+// Memo returns the memo of a transfer over the first path that is forwarded over the remaining paths to the
+// receiver on the last chain. Every forward uses the given retries and timeout; a zero timeout keeps the
+// default of the packetforward module. The memo is empty if the route has a single path.
+func (r *Route) Memo(receiver string, retries uint8, timeout time.Duration) string {
+	t := r.Paths[0].EndpointA.Chain.T
+	if len(r.Paths) == 1 {
+		return ""
+	}
+
+	hops := make([]types.RouteHop, 0, len(r.Paths)-1)
+	for _, path := range r.Paths[1:] {
+		hops = append(hops, types.NewRouteHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	}
+
+	metadata := &types.ForwardMetadata{
+		Receiver: receiver,
+		Timeout:  types.Duration(timeout),
+		Retries:  &retries,
+	}
+	require.NoError(t, metadata.ExpandRoute(types.NewRoute("", hops...)))
+
+	bz, err := json.Marshal(types.PacketMetadata{Forward: metadata})
+	require.NoError(t, err)
+	return string(bz)
+}
+
+// Transfer sends the coin from the sender account of the first chain over the first path with the memo, and
+// returns the sent packet.
+func (r *Route) Transfer(coin sdk.Coin, receiver, memo string) channeltypes.Packet {
+	path := r.Paths[0]
+	chain := path.EndpointA.Chain
+
+	msg := transfertypes.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		coin,
+		chain.SenderAccount.GetAddress().String(),
+		receiver,
+		clienttypes.ZeroHeight(),
+		uint64(chain.CurrentHeader.Time.Add(DefaultTransferTimeout).UnixNano()),
+		memo,
+	)
+	res, err := chain.SendMsgs(msg)
+	require.NoError(chain.T, err)
+
+	result, err := parseRelayResult(res)
+	require.NoError(chain.T, err)
+	require.NotNil(chain.T, result.Packet, "transfer did not send a packet")
+	return *result.Packet
+}
+
+// Forward sends the coin from the sender account of the first chain to the receiver on the last chain, forwarded
+// over every path of the route, and returns the packet sent over the first path.
+func (r *Route) Forward(coin sdk.Coin, receiver string, retries uint8, timeout time.Duration) channeltypes.Packet {
+	if len(r.Paths) == 1 {
+		return r.Transfer(coin, receiver, "")
+	}
+	return r.Transfer(coin, types.IntermediateReceiver, r.Memo(receiver, retries, timeout))
+}
+
+// RelayHops relays the packet, sent over the first path, through the first n paths of the route. Each chain
+// must forward the packet it receives. It returns the packets sent over each of the first n+1 paths, the last
+// one of which is not relayed.
+func (r *Route) RelayHops(packet channeltypes.Packet, n int) []channeltypes.Packet {
+	packets := []channeltypes.Packet{packet}
+	for i := 0; i < n; i++ {
+		chain := r.Paths[i].EndpointB.Chain
+
+		result, err := RelayPacket(r.Paths[i], packets[i])
+		require.NoError(chain.T, err)
+		require.Nil(chain.T, result.Ack, "%s acknowledged the packet instead of forwarding it", chain.ChainID)
+		require.NotNil(chain.T, result.Packet, "%s did not forward the packet", chain.ChainID)
+		packets = append(packets, *result.Packet)
+	}
+	return packets
+}
+
+// Relay relays the packet, sent over the first path, through the route until a chain acknowledges it, and then
+// relays the acknowledgements back to the first chain. It returns the acknowledgement of the packet.
+func (r *Route) Relay(packet channeltypes.Packet) []byte {
+	packets := []channeltypes.Packet{packet}
+	for i := range r.Paths {
+		chain := r.Paths[i].EndpointB.Chain
+
+		result, err := RelayPacket(r.Paths[i], packets[i])
+		require.NoError(chain.T, err)
+		if result.Ack != nil {
+			return r.RelayAcks(packets, result.Ack)
+		}
+		require.NotNil(chain.T, result.Packet, "%s neither acknowledged nor forwarded the packet", chain.ChainID)
+		packets = append(packets, *result.Packet)
+	}
+
+	require.FailNow(r.Paths[0].EndpointA.Chain.T, "packet was forwarded past the last chain of the route")
+	return nil
+}
+
+// RelayAcks relays the acknowledgement of the last of the packets, which were sent over the first paths of the
+// route, back to the first chain. Each chain writes the acknowledgement of the packet it received once the
+// packet it forwarded is acknowledged. It returns the acknowledgement of the first packet.
+func (r *Route) RelayAcks(packets []channeltypes.Packet, ack []byte) []byte {
+	for i := len(packets) - 1; i >= 0; i-- {
+		chain := r.Paths[i].EndpointA.Chain
+
+		result, err := RelayAck(r.Paths[i], packets[i], ack)
+		require.NoError(chain.T, err)
+		if i == 0 {
+			break
+		}
+		require.NotNil(chain.T, result.Ack, "%s did not acknowledge the packet it forwarded", chain.ChainID)
+		ack = result.Ack
+	}
+	return ack
+}
+
+// Timeout times out the packet sent over the i-th path of the route. The result holds the packet retried by
+// the chain, or the acknowledgement it wrote for the packet it received.
+func (r *Route) Timeout(i int, packet channeltypes.Packet) RelayResult {
+	result, err := RelayTimeout(r.Paths[i], packet)
+	require.NoError(r.Paths[i].EndpointA.Chain.T, err)
+	return result
+}
+
+// Denom returns the denom on the i-th chain of the route of a token with the base denom that is native to
+// the first chain and was transferred over the route.
+func (r *Route) Denom(i int, baseDenom string) string {
+	fullDenomPath := baseDenom
+	for _, path := range r.Paths[:i] {
+		fullDenomPath = transfertypes.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, fullDenomPath)
+	}
+	return transfertypes.ParseDenomTrace(fullDenomPath).IBCDenom()
+}
@@ -467,16 +467,11 @@ func NewSimApp(
 	var transferStack ibcporttypes.IBCModule
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
 
-	transferStack = packetforward.NewIBCMiddleware(
-		transferStack,
-		app.PacketForwardKeeper,
-		0, // retries on timeout
-		packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp, // forward timeout
-		packetforwardkeeper.DefaultRefundTransferPacketTimeoutTimestamp,  // refund timeout
-	)
+	forwardMiddleware := packetforward.NewIBCMiddlewareWithParams(transferStack, app.PacketForwardKeeper)
+	transferStack = forwardMiddleware
 
 	if os.Getenv("NON_REFUNDABLE_TEST") != "" {
-		transferStack = dummyware.NewIBCMiddleware(transferStack)
+		transferStack = dummyware.NewIBCMiddleware(forwardMiddleware)
 	}
 
 	// Add IBC Router
@@ -584,6 +579,10 @@ func NewSimApp(
 	// transactions
 	overrideModules := map[string]module.AppModuleSimulation{
 		authtypes.ModuleName: auth.NewAppModule(app.appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts, app.GetSubspace(authtypes.ModuleName)),
+		packetforwardtypes.ModuleName: packetforward.NewAppModuleWithSimulation(
+			app.PacketForwardKeeper, app.GetSubspace(packetforwardtypes.ModuleName),
+			app.AccountKeeper, app.BankKeeper, app.IBCKeeper.ChannelKeeper, app.TransferKeeper,
+		),
 	}
 	app.sm = module.NewSimulationManagerFromAppModules(app.mm.Modules, overrideModules)
 
@@ -0,0 +1,65 @@
+package simapp
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
+)
+
+// SimAppChainID hardcoded chainID for simulation
+const SimAppChainID = "simulation-app"
+
+// Get flags every time the simulator is run
+func init() {
+	simcli.GetSimulatorFlags()
+}
+
+// TestFullAppSimulation runs a randomized simulation in which the packetforward module forwards transfers over
+// loopback channels. The invariants of all modules, including the in-flight and escrow invariants of the
+// packetforward module, are asserted at the end of every block. Run it with:
+//
+//	go test ./testing/simapp -run TestFullAppSimulation -Enabled=true -Commit=true -NumBlocks=100 -BlockSize=50 -v
+func TestFullAppSimulation(t *testing.T) {
+	config := simcli.NewConfigFromFlags()
+	config.ChainID = SimAppChainID
+
+	db, dir, logger, skip, err := simtestutil.SetupSimulation(
+		config, "leveldb-app-sim", "Simulation", simcli.FlagVerboseValue, simcli.FlagEnabledValue,
+	)
+	if skip {
+		t.Skip("skipping application simulation")
+	}
+	require.NoError(t, err, "simulation setup failed")
+
+	defer func() {
+		require.NoError(t, db.Close())
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	// assert the invariants after every block.
+	app := NewSimApp(logger, db, nil, true, map[int64]bool{}, DefaultNodeHome, 1, EmptyAppOptions{},
+		baseapp.SetChainID(SimAppChainID))
+
+	_, simParams, simErr := simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		app.BaseApp,
+		simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), NewDefaultGenesisState(app.AppCodec())),
+		simtypes.RandomAccounts,
+		simtestutil.SimulationOperations(app, app.AppCodec(), config),
+		app.ModuleAccountAddrs(),
+		config,
+		app.AppCodec(),
+	)
+
+	// export state and simParams before the simulation error is checked
+	require.NoError(t, simtestutil.CheckExportSimulation(app, config, simParams))
+	require.NoError(t, simErr)
+}
@@ -1,8 +1,6 @@
 package dummyware
 
 import (
-	"context"
-
 	forwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,14 +13,20 @@ import (
 
 var _ porttypes.IBCModule = &IBCMiddleware{}
 
+// ForwardMiddleware is the packet forward middleware wrapped by the dummy middleware.
+type ForwardMiddleware interface {
+	porttypes.IBCModule
+	forwardtypes.ForwardRequester
+}
+
 // IBCMiddleware implements the ICS26 callbacks for the dummy middleware given the
 // dummy keeper and the underlying application.
 type IBCMiddleware struct {
-	app porttypes.IBCModule
+	app ForwardMiddleware
 }
 
 // NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying application.
-func NewIBCMiddleware(app porttypes.IBCModule) IBCMiddleware {
+func NewIBCMiddleware(app ForwardMiddleware) IBCMiddleware {
 	return IBCMiddleware{
 		app: app,
 	}
@@ -80,19 +84,15 @@ func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID st
 	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
 }
 
-// OnRecvPacket sets the non-refundable context value to true so that on a failed forward funds will not
+// OnRecvPacket sets the non-refundable forward option so that on a failed forward funds will not
 // be refunded from the intermediate chain.
 func (im IBCMiddleware) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
-	// Compose our context with values that will be used to pass through to the forward middleware
-	ctxWithFlags := context.WithValue(ctx.Context(), forwardtypes.NonrefundableKey{}, true)
-	wrappedCtx := ctx.WithContext(ctxWithFlags)
-
-	// Call into underlying app to receive funds on this chain
-	return im.app.OnRecvPacket(wrappedCtx, packet, relayer)
+	// Call into the forward middleware to receive funds on this chain
+	return im.app.OnRecvPacketWithOptions(ctx, packet, relayer, forwardtypes.ForwardOptions{Nonrefundable: true})
 }
 
 // OnAcknowledgementPacket implements the IBCModule interface.